@@ -315,8 +315,8 @@ func UnpackEvidenceHeader(txData []byte) (*BlockHeader, error) {
 	return decodeEvidenceHeader(inputs[0])
 }
 
-// decodeEvidenceHeader decodes the encoded evidence bytes, and then returns its inner header.
-func decodeEvidenceHeader(evidenceBytes []byte) (*BlockHeader, error) {
+// DecodeEvidence decodes the encoded evidence bytes.
+func DecodeEvidence(evidenceBytes []byte) (*TaikoL1Evidence, error) {
 	unpacked, err := EvidenceArgs.Unpack(evidenceBytes)
 	if err != nil {
 		return nil, fmt.Errorf("failed to decode evidence meta")
@@ -327,5 +327,15 @@ func decodeEvidenceHeader(evidenceBytes []byte) (*BlockHeader, error) {
 		return nil, err
 	}
 
+	return evidence, nil
+}
+
+// decodeEvidenceHeader decodes the encoded evidence bytes, and then returns its inner header.
+func decodeEvidenceHeader(evidenceBytes []byte) (*BlockHeader, error) {
+	evidence, err := DecodeEvidence(evidenceBytes)
+	if err != nil {
+		return nil, err
+	}
+
 	return &evidence.Header, nil
 }
@@ -118,4 +118,9 @@ func TestDecodeEvidenceHeader(t *testing.T) {
 	header, err := decodeEvidenceHeader(b)
 	require.Nil(t, err)
 	require.Equal(t, FromGethHeader(testHeader), header)
+
+	evidence, err := DecodeEvidence(b)
+	require.Nil(t, err)
+	require.Equal(t, FromGethHeader(testHeader), &evidence.Header)
+	require.Len(t, evidence.Proofs, 1)
 }
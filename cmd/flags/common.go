@@ -1,6 +1,8 @@
 package flags
 
 import (
+	"time"
+
 	"github.com/urfave/cli/v2"
 )
 
@@ -17,13 +19,13 @@ var (
 var (
 	L1WSEndpoint = cli.StringFlag{
 		Name:     "l1.ws",
-		Usage:    "Websocket RPC endpoint of a L1 ethereum node",
+		Usage:    "Websocket or HTTP RPC endpoint of a L1 ethereum node, subscriptions are polled over HTTP",
 		Required: true,
 		Category: commonCategory,
 	}
 	L2WSEndpoint = cli.StringFlag{
 		Name:     "l2.ws",
-		Usage:    "Websocket RPC endpoint of a L2 taiko-geth execution engine",
+		Usage:    "Websocket or HTTP RPC endpoint of a L2 taiko-geth execution engine, subscriptions are polled over HTTP",
 		Required: true,
 		Category: commonCategory,
 	}
@@ -40,6 +42,46 @@ var (
 		Category: commonCategory,
 	}
 	// Optional flags used by all client softwares.
+	// L1 RPC failover
+	L1WSFallbackEndpoints = &cli.StringSliceFlag{
+		Name: "l1.ws.fallbacks",
+		Usage: "Websocket or HTTP RPC endpoints of fallback L1 ethereum nodes, in order of preference, " +
+			"used when the primary one is unhealthy or lagging behind",
+		Category: commonCategory,
+	}
+	L1HealthCheckInterval = &cli.DurationFlag{
+		Name:     "l1.healthCheckInterval",
+		Usage:    "Interval between two health checks of all L1 endpoints",
+		Value:    10 * time.Second,
+		Category: commonCategory,
+	}
+	L1MaxHeadLag = &cli.Uint64Flag{
+		Name:     "l1.maxHeadLag",
+		Usage:    "Maximum number of blocks the used L1 endpoint's head can lag behind the other healthy ones",
+		Value:    5,
+		Category: commonCategory,
+	}
+	// RPC rate limiting
+	L1RateLimit = &cli.Uint64Flag{
+		Name:     "l1.rateLimit",
+		Usage:    "Maximum number of L1 RPC requests per second, excluding log queries, 0 means unlimited",
+		Category: commonCategory,
+	}
+	L1LogsRateLimit = &cli.Uint64Flag{
+		Name:     "l1.logsRateLimit",
+		Usage:    "Maximum number of L1 eth_getLogs requests per second, 0 means unlimited",
+		Category: commonCategory,
+	}
+	L2RateLimit = &cli.Uint64Flag{
+		Name:     "l2.rateLimit",
+		Usage:    "Maximum number of L2 RPC requests per second, excluding log queries, 0 means unlimited",
+		Category: commonCategory,
+	}
+	L2LogsRateLimit = &cli.Uint64Flag{
+		Name:     "l2.logsRateLimit",
+		Usage:    "Maximum number of L2 eth_getLogs requests per second, 0 means unlimited",
+		Category: commonCategory,
+	}
 	// Logging
 	Verbosity = &cli.IntFlag{
 		Name:     "verbosity",
@@ -81,6 +123,13 @@ var CommonFlags = []cli.Flag{
 	&TaikoL1Address,
 	&TaikoL2Address,
 	// Optional
+	L1WSFallbackEndpoints,
+	L1HealthCheckInterval,
+	L1MaxHeadLag,
+	L1RateLimit,
+	L1LogsRateLimit,
+	L2RateLimit,
+	L2LogsRateLimit,
 	Verbosity,
 	LogJson,
 	MetricsEnabled,
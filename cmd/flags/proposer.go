@@ -35,6 +35,60 @@ var (
 		Value:    rand.Uint64(),
 		Category: proposerCategory,
 	}
+	CommitSlotStateFile = cli.StringFlag{
+		Name: "commitSlotStateFile",
+		Usage: "Path of a file to persist the in-use commit slots, " +
+			"so that they won't be reused before being consumed after restarts",
+		Category: proposerCategory,
+	}
+	MaxDerivationLag = cli.Uint64Flag{
+		Name: "maxDerivationLag",
+		Usage: "Maximum number of proposed blocks which have not been inserted into the L2 execution engine " +
+			"yet, proposer will only propose new transactions when the lag is within this bound, " +
+			"zero means waiting until all proposed blocks are inserted",
+		Value:    32,
+		Category: proposerCategory,
+	}
+	ProposeTxsThreshold = cli.Uint64Flag{
+		Name:     "proposeTxsThreshold",
+		Usage:    "If set, proposer will propose immediately once the number of new pending transactions reaches it",
+		Category: proposerCategory,
+	}
+	ProposeGasThreshold = cli.Uint64Flag{
+		Name: "proposeGasThreshold",
+		Usage: "If set, proposer will propose immediately once the accumulated gas limit of " +
+			"new pending transactions reaches it",
+		Category: proposerCategory,
+	}
+	ProposeMaxLatency = cli.StringFlag{
+		Name:     "proposeMaxLatency",
+		Usage:    "If set, proposer will propose immediately before the oldest new pending transaction gets older than it",
+		Category: proposerCategory,
+	}
+	ProposeL1SlotWindow = cli.StringFlag{
+		Name: "proposeL1SlotWindow",
+		Usage: "If set, proposer will only propose within the given offsets of a 12s L1 slot, " +
+			"using the format: `lowerBound-upperBound` (e.g. `0s-4s`)",
+		Category: proposerCategory,
+	}
+	ProposeEmptyBlocksInterval = cli.StringFlag{
+		Name: "proposeEmptyBlocksInterval",
+		Usage: "If set, proposer will propose an empty block as heartbeat when the transaction pool is empty " +
+			"and no block has been proposed within this time interval",
+		Category: proposerCategory,
+	}
+	EmptyBlocksMaxGasPrice = cli.Uint64Flag{
+		Name:     "emptyBlocksMaxGasPrice",
+		Usage:    "If set, proposer will skip proposing empty blocks when the L1 gas price (in wei) exceeds it",
+		Category: proposerCategory,
+	}
+	SimulateTxLists = cli.BoolFlag{
+		Name: "simulateTxLists",
+		Usage: "Simulate the transactions lists against the L2 head state before proposing, and drop the " +
+			"transactions which would be skipped because of invalid nonces or insufficient balances",
+		Value:    false,
+		Category: proposerCategory,
+	}
 	ShufflePoolContent = cli.BoolFlag{
 		Name:     "shufflePoolContent",
 		Usage:    "Perform a weighted shuffle when building the transactions list to propose",
@@ -50,4 +104,13 @@ var ProposerFlags = MergeFlags(CommonFlags, []cli.Flag{
 	&ProposeInterval,
 	&ShufflePoolContent,
 	&CommitSlot,
+	&CommitSlotStateFile,
+	&MaxDerivationLag,
+	&ProposeTxsThreshold,
+	&ProposeGasThreshold,
+	&ProposeMaxLatency,
+	&ProposeL1SlotWindow,
+	&ProposeEmptyBlocksInterval,
+	&EmptyBlocksMaxGasPrice,
+	&SimulateTxLists,
 })
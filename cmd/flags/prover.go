@@ -1,14 +1,17 @@
 package flags
 
 import (
+	"time"
+
 	"github.com/urfave/cli/v2"
 )
 
 // Required flags used by prover.
 var (
-	ZkEvmRpcdEndpoint = cli.StringFlag{
-		Name:     "zkevmRpcdEndpoint",
-		Usage:    "RPC endpoint of a ZKEVM RPCD service",
+	ZkEvmRpcdEndpoint = cli.StringSliceFlag{
+		Name: "zkevmRpcdEndpoint",
+		Usage: "RPC endpoint of a ZKEVM RPCD service, multiple endpoints can be given (separated by commas) " +
+			"to load-balance the proof requests among them",
 		Required: true,
 		Category: proverCategory,
 	}
@@ -29,6 +32,13 @@ var (
 
 // Optional flags used by prover.
 var (
+	ZkEvmRpcdProofTimeout = cli.DurationFlag{
+		Name: "zkevmRpcdProofTimeout",
+		Usage: "Timeout of generating a proof by the ZKEVM RPCD service, " +
+			"the block will be proven again after timeout, zero means no timeout",
+		Value:    time.Hour,
+		Category: proverCategory,
+	}
 	StartingBlockID = cli.Uint64Flag{
 		Name:     "startingBlockID",
 		Usage:    "If set, prover will start proving blocks from the block with this ID",
@@ -40,6 +50,69 @@ var (
 		Value:    1,
 		Category: proverCategory,
 	}
+	ProvingJobsStateFile = cli.StringFlag{
+		Name: "provingJobsStateFile",
+		Usage: "Path of a file to persist the proving jobs, " +
+			"so that they can be resumed without generating the same proofs again after restarts",
+		Category: proverCategory,
+	}
+	ProveBlocksMinAge = cli.DurationFlag{
+		Name:     "proveBlocksMinAge",
+		Usage:    "If set, prover will only prove the blocks which were proposed at least this long ago",
+		Category: proverCategory,
+	}
+	ProveBlocksShard = cli.StringFlag{
+		Name: "proveBlocksShard",
+		Usage: "If set, prover will only prove the blocks whose IDs modulo total equal to index, using the format: " +
+			"`index/total` (e.g. `0/3`), so that a fleet of provers can split the work",
+		Category: proverCategory,
+	}
+	VerifyBlocks = cli.BoolFlag{
+		Name: "verifyBlocks",
+		Usage: "Send TaikoL1.verifyBlocks transactions automatically, " +
+			"when verifying the proven blocks is profitable or overdue",
+		Value:    false,
+		Category: proverCategory,
+	}
+	VerifyBlocksInterval = cli.DurationFlag{
+		Name:     "verifyBlocks.interval",
+		Usage:    "Interval between two checks of the proven but unverified blocks",
+		Value:    1 * time.Minute,
+		Category: proverCategory,
+	}
+	VerifyBlocksThreshold = cli.Uint64Flag{
+		Name: "verifyBlocks.threshold",
+		Usage: "Minimum number of proven but unverified blocks to send a TaikoL1.verifyBlocks transaction, " +
+			"0 means the protocol's maximum verifications per transaction",
+		Value:    0,
+		Category: proverCategory,
+	}
+	VerifyBlocksMaxDelay = cli.DurationFlag{
+		Name: "verifyBlocks.maxDelay",
+		Usage: "Send a TaikoL1.verifyBlocks transaction anyway, " +
+			"if the oldest proven block was proposed at least this long ago",
+		Value:    10 * time.Minute,
+		Category: proverCategory,
+	}
+	ProofArtifactsDir = cli.StringFlag{
+		Name: "proofArtifactsDir",
+		Usage: "Directory to save every generated proof with its evidence inputs, " +
+			"so that the proof can be re-sent by the prover submit command later",
+		Category: proverCategory,
+	}
+	AdminAPIAddr = cli.StringFlag{
+		Name: "adminAPI.addr",
+		Usage: "If set, prover will serve the admin JSON-RPC API (under the `prover` namespace) on this address, " +
+			"to inspect and control the proving jobs",
+		Category: proverCategory,
+	}
+	RederiveBlocks = cli.BoolFlag{
+		Name: "rederiveBlocks",
+		Usage: "Re-derive every block from its L1 TaikoL1.proposeBlock transaction calldata before proving, " +
+			"and refuse to prove the block if the L2 execution engine's block mismatches",
+		Value:    false,
+		Category: proverCategory,
+	}
 	// Special flags for testing.
 	Dummy = cli.BoolFlag{
 		Name:     "dummy",
@@ -55,13 +128,63 @@ var (
 	}
 )
 
+// Flags used by the one-shot prove command.
+var (
+	ProveFromBlockID = cli.Uint64Flag{
+		Name:     "from",
+		Usage:    "ID of the first block to prove",
+		Required: true,
+		Category: proverCategory,
+	}
+	ProveToBlockID = cli.Uint64Flag{
+		Name:     "to",
+		Usage:    "ID of the last block to prove",
+		Required: true,
+		Category: proverCategory,
+	}
+)
+
+// Flags used by the proof submit command.
+var (
+	ProofArtifactFile = cli.StringFlag{
+		Name:     "file",
+		Usage:    "Path of a saved proof artifact file to submit",
+		Required: true,
+		Category: proverCategory,
+	}
+)
+
 // All prover flags.
 var ProverFlags = MergeFlags(CommonFlags, []cli.Flag{
 	&ZkEvmRpcdEndpoint,
 	&ZkEvmRpcdParamsPath,
+	&ZkEvmRpcdProofTimeout,
 	&L1ProverPrivKey,
 	&StartingBlockID,
 	&MaxConcurrentProvingJobs,
+	&ProvingJobsStateFile,
+	&ProveBlocksMinAge,
+	&ProveBlocksShard,
+	&VerifyBlocks,
+	&VerifyBlocksInterval,
+	&VerifyBlocksThreshold,
+	&VerifyBlocksMaxDelay,
+	&ProofArtifactsDir,
+	&AdminAPIAddr,
+	&RederiveBlocks,
 	&Dummy,
 	&RandomDummyProofDelay,
 })
+
+// All prover one-shot prove command flags, the prover flags should be given to the
+// parent prover command.
+var ProverProveFlags = []cli.Flag{
+	&ProveFromBlockID,
+	&ProveToBlockID,
+}
+
+// All prover proof submit command flags, the prover flags should be given to the
+// parent prover command.
+var ProverSubmitFlags = []cli.Flag{
+	&ProofArtifactFile,
+}
@@ -52,6 +52,24 @@ func main() {
 			Usage:       "Starts the prover software",
 			Description: "Taiko prover software",
 			Action:      utils.SubcommandAction(new(prover.Prover)),
+			Subcommands: []*cli.Command{
+				{
+					Name:        "prove",
+					Flags:       flags.ProverProveFlags,
+					Usage:       "Proves the blocks in the given range once, then exits",
+					UsageText:   "prover [prover options] prove --from value --to value",
+					Description: "Taiko prover one-shot prove command, proves exactly the blocks from --from to --to",
+					Action:      utils.OneShotAction(new(prover.RangeProver)),
+				},
+				{
+					Name:        "submit",
+					Flags:       flags.ProverSubmitFlags,
+					Usage:       "Re-sends a saved proof with fresh gas parameters, then exits",
+					UsageText:   "prover [prover options] submit --file value",
+					Description: "Taiko prover proof submit command, re-sends a proof saved in --proofArtifactsDir",
+					Action:      utils.OneShotAction(new(prover.ProofSubmitter)),
+				},
+			},
 		},
 	}
 
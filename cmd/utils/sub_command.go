@@ -19,6 +19,14 @@ type SubcommandApplication interface {
 	Close()
 }
 
+// OneShotApplication is an application which exits once its work is done.
+type OneShotApplication interface {
+	InitFromCli(context.Context, *cli.Context) error
+	Name() string
+	Run() error
+	Close()
+}
+
 func SubcommandAction(app SubcommandApplication) cli.ActionFunc {
 	return func(c *cli.Context) error {
 		logger.InitLogger(c)
@@ -60,3 +68,42 @@ func SubcommandAction(app SubcommandApplication) cli.ActionFunc {
 		return nil
 	}
 }
+
+func OneShotAction(app OneShotApplication) cli.ActionFunc {
+	return func(c *cli.Context) error {
+		logger.InitLogger(c)
+
+		ctx, ctxClose := context.WithCancel(context.Background())
+		defer func() { ctxClose() }()
+
+		if err := app.InitFromCli(ctx, c); err != nil {
+			return err
+		}
+
+		log.Info("Running Taiko client application", "name", app.Name())
+
+		defer func() {
+			ctxClose()
+			app.Close()
+			log.Info("Application stopped", "name", app.Name())
+		}()
+
+		// Stop the application if it is interrupted.
+		quitCh := make(chan os.Signal, 1)
+		signal.Notify(quitCh, []os.Signal{
+			os.Interrupt,
+			os.Kill,
+			syscall.SIGTERM,
+			syscall.SIGQUIT,
+		}...)
+		go func() {
+			select {
+			case <-quitCh:
+				ctxClose()
+			case <-ctx.Done():
+			}
+		}()
+
+		return app.Run()
+	}
+}
@@ -15,6 +15,13 @@ import (
 // Config contains the configurations to initialize a Taiko driver.
 type Config struct {
 	L1Endpoint                    string
+	L1FallbackEndpoints           []string
+	L1HealthCheckInterval         time.Duration
+	L1MaxHeadLag                  uint64
+	L1RateLimit                   uint64
+	L1LogsRateLimit               uint64
+	L2RateLimit                   uint64
+	L2LogsRateLimit               uint64
 	L2Endpoint                    string
 	L2EngineEndpoint              string
 	TaikoL1Address                common.Address
@@ -40,6 +47,13 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 
 	return &Config{
 		L1Endpoint:                    c.String(flags.L1WSEndpoint.Name),
+		L1FallbackEndpoints:           c.StringSlice(flags.L1WSFallbackEndpoints.Name),
+		L1HealthCheckInterval:         c.Duration(flags.L1HealthCheckInterval.Name),
+		L1MaxHeadLag:                  c.Uint64(flags.L1MaxHeadLag.Name),
+		L1RateLimit:                   c.Uint64(flags.L1RateLimit.Name),
+		L1LogsRateLimit:               c.Uint64(flags.L1LogsRateLimit.Name),
+		L2RateLimit:                   c.Uint64(flags.L2RateLimit.Name),
+		L2LogsRateLimit:               c.Uint64(flags.L2LogsRateLimit.Name),
 		L2Endpoint:                    c.String(flags.L2WSEndpoint.Name),
 		L2EngineEndpoint:              c.String(flags.L2AuthEndpoint.Name),
 		TaikoL1Address:                common.HexToAddress(c.String(flags.TaikoL1Address.Name)),
@@ -53,12 +53,19 @@ func InitFromConfig(ctx context.Context, d *Driver, cfg *Config) (err error) {
 	d.ctx = ctx
 
 	if d.rpc, err = rpc.NewClient(d.ctx, &rpc.ClientConfig{
-		L1Endpoint:       cfg.L1Endpoint,
-		L2Endpoint:       cfg.L2Endpoint,
-		TaikoL1Address:   cfg.TaikoL1Address,
-		TaikoL2Address:   cfg.TaikoL2Address,
-		L2EngineEndpoint: cfg.L2EngineEndpoint,
-		JwtSecret:        cfg.JwtSecret,
+		L1Endpoint:            cfg.L1Endpoint,
+		L1FallbackEndpoints:   cfg.L1FallbackEndpoints,
+		L1HealthCheckInterval: cfg.L1HealthCheckInterval,
+		L1MaxHeadLag:          cfg.L1MaxHeadLag,
+		L1RateLimit:           cfg.L1RateLimit,
+		L1LogsRateLimit:       cfg.L1LogsRateLimit,
+		L2RateLimit:           cfg.L2RateLimit,
+		L2LogsRateLimit:       cfg.L2LogsRateLimit,
+		L2Endpoint:            cfg.L2Endpoint,
+		TaikoL1Address:        cfg.TaikoL1Address,
+		TaikoL2Address:        cfg.TaikoL2Address,
+		L2EngineEndpoint:      cfg.L2EngineEndpoint,
+		JwtSecret:             cfg.JwtSecret,
 	}); err != nil {
 		return err
 	}
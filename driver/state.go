@@ -241,7 +241,7 @@ func (s *State) GetL1Head() *types.Header {
 func (s *State) watchL2Head(ctx context.Context) (event.Subscription, error) {
 	newL2HeadCh := make(chan *types.Header, 10)
 
-	sub, err := s.rpc.L2.SubscribeNewHead(ctx, newL2HeadCh)
+	sub, err := s.rpc.SubscribeL2NewHead(ctx, newL2HeadCh)
 	if err != nil {
 		log.Error("Create L2 head subscription error", "error", err)
 		return nil, err
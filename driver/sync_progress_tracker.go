@@ -8,8 +8,8 @@ import (
 
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/ethereum/go-ethereum/log"
+	"github.com/taikoxyz/taiko-client/pkg/rpc"
 )
 
 var (
@@ -21,7 +21,7 @@ var (
 // connected peer or some other reasons).
 type BeaconSyncProgressTracker struct {
 	// RPC client
-	client *ethclient.Client
+	client *rpc.L2Client
 
 	// Meta data
 	triggered                     bool
@@ -41,7 +41,7 @@ type BeaconSyncProgressTracker struct {
 }
 
 // NewBeaconSyncProgressTracker creates a new BeaconSyncProgressTracker instance.
-func NewBeaconSyncProgressTracker(c *ethclient.Client, timeout time.Duration) *BeaconSyncProgressTracker {
+func NewBeaconSyncProgressTracker(c *rpc.L2Client, timeout time.Duration) *BeaconSyncProgressTracker {
 	return &BeaconSyncProgressTracker{client: c, timeout: timeout, ticker: time.NewTicker(syncProgressFetchInterval)}
 }
 
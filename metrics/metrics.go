@@ -4,7 +4,10 @@ import (
 	"context"
 	"net"
 	"net/http"
+	"net/url"
+	"regexp"
 	"strconv"
+	"strings"
 
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/metrics"
@@ -23,10 +26,22 @@ var (
 	DriverL2VerifiedHeightGauge = metrics.NewRegisteredGauge("driver/l2Verified/id", nil)
 
 	// Proposer
-	ProposerProposeEpochCounter    = metrics.NewRegisteredCounter("proposer/epoch", nil)
-	ProposerProposedTxListsCounter = metrics.NewRegisteredCounter("proposer/proposed/txLists", nil)
-	ProposerProposedTxsCounter     = metrics.NewRegisteredCounter("proposer/proposed/txs", nil)
-	ProposerInvalidTxsCounter      = metrics.NewRegisteredCounter("proposer/invalid/txs", nil)
+	ProposerProposeEpochCounter         = metrics.NewRegisteredCounter("proposer/epoch", nil)
+	ProposerProposedTxListsCounter      = metrics.NewRegisteredCounter("proposer/proposed/txLists", nil)
+	ProposerProposedTxsCounter          = metrics.NewRegisteredCounter("proposer/proposed/txs", nil)
+	ProposerInvalidTxsCounter           = metrics.NewRegisteredCounter("proposer/invalid/txs", nil)
+	ProposerL2DerivationLagGauge        = metrics.NewRegisteredGauge("proposer/l2Derivation/lag", nil)
+	ProposerTriggeredProposeCounter     = metrics.NewRegisteredCounter("proposer/triggered", nil)
+	ProposerProposedEmptyBlocksCounter  = metrics.NewRegisteredCounter("proposer/proposed/emptyBlocks", nil)
+	ProposerSkippedEmptyBlocksCounter   = metrics.NewRegisteredCounter("proposer/skipped/emptyBlocks", nil)
+	ProposerSimulationDroppedTxsCounter = metrics.NewRegisteredCounter("proposer/simulation/dropped/txs", nil)
+
+	// RPC
+	RPCEndpointSwitchedCounter = metrics.NewRegisteredCounter("rpc/endpoint/switched", nil)
+	RPCL1CacheHitCounter       = metrics.NewRegisteredCounter("rpc/l1/cache/hit", nil)
+	RPCL1CacheMissCounter      = metrics.NewRegisteredCounter("rpc/l1/cache/miss", nil)
+	RPCL1ThrottledCounter      = metrics.NewRegisteredCounter("rpc/l1/throttled", nil)
+	RPCL2ThrottledCounter      = metrics.NewRegisteredCounter("rpc/l2/throttled", nil)
 
 	// Prover
 	ProverLatestVerifiedIDGauge       = metrics.NewRegisteredGauge("prover/latestVerified/id", nil)
@@ -39,9 +54,50 @@ var (
 	ProverSentProofCounter            = metrics.NewRegisteredCounter("prover/proof/all/sent", nil)
 	ProverSentValidProofCounter       = metrics.NewRegisteredCounter("prover/proof/valid/sent", nil)
 	ProverSentInvalidProofCounter     = metrics.NewRegisteredCounter("prover/proof/invalid/sent", nil)
+	ProverCancelledProofCounter       = metrics.NewRegisteredCounter("prover/proof/all/cancelled", nil)
 	ProverReceivedProposedBlockGauge  = metrics.NewRegisteredGauge("prover/proposed/received", nil)
+	ProverVerifiableBlocksGauge       = metrics.NewRegisteredGauge("prover/verifiable/blocks", nil)
+	ProverVerifyBlocksSentCounter     = metrics.NewRegisteredCounter("prover/verifyBlocks/sent", nil)
+	ProverRederivationMismatchCounter = metrics.NewRegisteredCounter("prover/rederivation/mismatch", nil)
+	ProverReorgedProposedEventCounter = metrics.NewRegisteredCounter("prover/proposed/reorged", nil)
+	ProverL1CurrentRewoundCounter     = metrics.NewRegisteredCounter("prover/l1Current/rewound", nil)
+	ProverInFlightProvingJobsGauge    = metrics.NewRegisteredGauge("prover/provingJobs/inFlight", nil)
+	ProverOldestUnprovenBlockAgeGauge = metrics.NewRegisteredGauge("prover/unproven/oldest/age", nil)
+	ProverProposalToRequestTimer      = metrics.NewRegisteredTimer("prover/latency/proposalToRequest", nil)
+	ProverProofGenerationTimer        = metrics.NewRegisteredTimer("prover/latency/proofGeneration", nil)
+	ProverProofToSubmissionTimer      = metrics.NewRegisteredTimer("prover/latency/proofToSubmission", nil)
+	ProverSubmissionToReceiptTimer    = metrics.NewRegisteredTimer("prover/latency/submissionToReceipt", nil)
 )
 
+// ProverRpcdMetrics holds the metrics of a single ZKEVM RPCD backend used by the prover.
+type ProverRpcdMetrics struct {
+	QueueDepthGauge  metrics.Gauge
+	LatencyTimer     metrics.Timer
+	FailuresCounter  metrics.Counter
+	UnhealthyCounter metrics.Counter
+}
+
+// invalidMetricNameChars matches all characters which are not allowed in a metric name.
+var invalidMetricNameChars = regexp.MustCompile(`[^a-zA-Z0-9]+`)
+
+// NewProverRpcdMetrics returns the metrics of the ZKEVM RPCD backend with the given endpoint,
+// named after the endpoint's host and path, so they stay the same no matter how the backends
+// are ordered in the configuration.
+func NewProverRpcdMetrics(endpoint string) *ProverRpcdMetrics {
+	name := endpoint
+	if u, err := url.Parse(endpoint); err == nil && len(u.Host) != 0 {
+		name = u.Host + u.Path
+	}
+	prefix := "prover/rpcd/" + strings.Trim(invalidMetricNameChars.ReplaceAllString(name, "_"), "_")
+
+	return &ProverRpcdMetrics{
+		QueueDepthGauge:  metrics.GetOrRegisterGauge(prefix+"/queue", nil),
+		LatencyTimer:     metrics.GetOrRegisterTimer(prefix+"/latency", nil),
+		FailuresCounter:  metrics.GetOrRegisterCounter(prefix+"/failures", nil),
+		UnhealthyCounter: metrics.GetOrRegisterCounter(prefix+"/unhealthy", nil),
+	}
+}
+
 // Serve starts the metrics server on the given address, will be closed when the given
 // context is cancelled.
 func Serve(ctx context.Context, c *cli.Context) error {
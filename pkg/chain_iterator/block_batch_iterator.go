@@ -10,8 +10,8 @@ import (
 	"github.com/cenkalti/backoff/v4"
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/ethereum/go-ethereum/log"
+	"github.com/taikoxyz/taiko-client/pkg/rpc"
 )
 
 const (
@@ -42,7 +42,7 @@ type EndIterFunc func()
 // with the awareness of reorganization.
 type BlockBatchIterator struct {
 	ctx                context.Context
-	client             *ethclient.Client
+	client             *rpc.FailoverClient
 	chainID            *big.Int
 	blocksReadPerEpoch uint64
 	startHeight        uint64
@@ -55,7 +55,7 @@ type BlockBatchIterator struct {
 
 // BlockBatchIteratorConfig represents the configs of a block batch iterator.
 type BlockBatchIteratorConfig struct {
-	Client                *ethclient.Client
+	Client                *rpc.FailoverClient
 	MaxBlocksReadPerEpoch *uint64
 	StartHeight           *big.Int
 	EndHeight             *big.Int
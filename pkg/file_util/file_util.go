@@ -0,0 +1,16 @@
+package file_util
+
+import (
+	"os"
+)
+
+// WriteFileAtomic writes the given data to a temporary file at first, and then renames it to the
+// given path, so that the file won't be corrupted if the process exits while writing.
+func WriteFileAtomic(path string, data []byte, perm os.FileMode) error {
+	tmpPath := path + ".tmp"
+	if err := os.WriteFile(tmpPath, data, perm); err != nil {
+		return err
+	}
+
+	return os.Rename(tmpPath, path)
+}
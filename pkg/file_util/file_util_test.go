@@ -0,0 +1,27 @@
+package file_util
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteFileAtomic(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "state.json")
+
+	require.Nil(t, WriteFileAtomic(path, []byte("foo"), 0600))
+	require.Nil(t, WriteFileAtomic(path, []byte("bar"), 0600))
+
+	data, err := os.ReadFile(path)
+	require.Nil(t, err)
+	require.Equal(t, []byte("bar"), data)
+
+	// No temporary file should be left.
+	_, err = os.Stat(path + ".tmp")
+	require.True(t, os.IsNotExist(err))
+
+	// Directory not exists
+	require.NotNil(t, WriteFileAtomic(filepath.Join(path, "state.json"), []byte("foo"), 0600))
+}
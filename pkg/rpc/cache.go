@@ -0,0 +1,217 @@
+package rpc
+
+import (
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/taikoxyz/taiko-client/metrics"
+)
+
+const (
+	// Default maximum number of cached entries of each kind.
+	DefaultL1CacheSize = 1024
+	// Number of blocks behind the L1 head, after which a block is considered final and
+	// won't be reorged out.
+	l1CacheFinalityDepth = 64
+)
+
+// l1Cache is an LRU cache of L1 headers, blocks, transactions and receipts. Entries looked up by hash
+// never change, while the block height to hash mappings and the receipts might change in reorgs, so
+// they are only cached for the final blocks, and invalidated when the L1 head goes backwards.
+type l1Cache struct {
+	headers  *lru.Cache // block hash -> *types.Header
+	blocks   *lru.Cache // block hash -> *types.Block
+	txs      *lru.Cache // transaction hash -> *types.Transaction
+	receipts *lru.Cache // transaction hash -> *types.Receipt
+	hashes   *lru.Cache // block height -> block hash, final blocks only
+	head     uint64
+	mutex    sync.RWMutex
+}
+
+// newL1Cache creates a new L1 cache instance, which keeps at most size entries of each kind.
+func newL1Cache(size int) *l1Cache {
+	if size <= 0 {
+		size = DefaultL1CacheSize
+	}
+
+	// lru.New only fails when the size is not positive.
+	headers, _ := lru.New(size)
+	blocks, _ := lru.New(size)
+	txs, _ := lru.New(size)
+	receipts, _ := lru.New(size)
+	hashes, _ := lru.New(size)
+
+	return &l1Cache{headers: headers, blocks: blocks, txs: txs, receipts: receipts, hashes: hashes}
+}
+
+// setHead updates the L1 head, if the head goes backwards, invalidates the entries of the blocks
+// which are not final anymore.
+func (c *l1Cache) setHead(head uint64) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if head < c.head {
+		c.invalidateFrom(finalizedHeight(head) + 1)
+	}
+	c.head = head
+}
+
+// invalidateFrom removes the height to hash mappings and the receipts since the given height.
+func (c *l1Cache) invalidateFrom(height uint64) {
+	for _, key := range c.hashes.Keys() {
+		if key.(uint64) >= height {
+			c.hashes.Remove(key)
+		}
+	}
+
+	for _, key := range c.receipts.Keys() {
+		if receipt, ok := c.receipts.Peek(key); ok && receipt.(*types.Receipt).BlockNumber.Uint64() >= height {
+			c.receipts.Remove(key)
+		}
+	}
+}
+
+// isFinal checks whether the block at the given height is final.
+func (c *l1Cache) isFinal(height uint64) bool {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	return c.head >= l1CacheFinalityDepth && height <= finalizedHeight(c.head)
+}
+
+// finalizedHeight returns the highest final block height under the given head.
+func finalizedHeight(head uint64) uint64 {
+	if head < l1CacheFinalityDepth {
+		return 0
+	}
+
+	return head - l1CacheFinalityDepth
+}
+
+// isCacheableHeight checks whether the lookups by the given block number can be cached.
+func (c *l1Cache) isCacheableHeight(number *big.Int) bool {
+	return number != nil && number.Sign() >= 0 && number.IsUint64() && c.isFinal(number.Uint64())
+}
+
+// headerByHash looks up a header by its hash.
+func (c *l1Cache) headerByHash(hash common.Hash) (*types.Header, bool) {
+	if header, ok := c.headers.Get(hash); ok {
+		return recordHit(header.(*types.Header))
+	}
+
+	if block, ok := c.blocks.Get(hash); ok {
+		return recordHit(block.(*types.Block).Header())
+	}
+
+	return recordMiss[*types.Header]()
+}
+
+// headerByNumber looks up a header by its height, returns false if the height can't be cached.
+func (c *l1Cache) headerByNumber(number *big.Int) (*types.Header, bool) {
+	if !c.isCacheableHeight(number) {
+		return nil, false
+	}
+
+	hash, ok := c.hashes.Get(number.Uint64())
+	if !ok {
+		return recordMiss[*types.Header]()
+	}
+
+	return c.headerByHash(hash.(common.Hash))
+}
+
+// blockByHash looks up a block by its hash.
+func (c *l1Cache) blockByHash(hash common.Hash) (*types.Block, bool) {
+	if block, ok := c.blocks.Get(hash); ok {
+		return recordHit(block.(*types.Block))
+	}
+
+	return recordMiss[*types.Block]()
+}
+
+// blockByNumber looks up a block by its height, returns false if the height can't be cached.
+func (c *l1Cache) blockByNumber(number *big.Int) (*types.Block, bool) {
+	if !c.isCacheableHeight(number) {
+		return nil, false
+	}
+
+	hash, ok := c.hashes.Get(number.Uint64())
+	if !ok {
+		return recordMiss[*types.Block]()
+	}
+
+	return c.blockByHash(hash.(common.Hash))
+}
+
+// transactionByHash looks up a transaction by its hash.
+func (c *l1Cache) transactionByHash(hash common.Hash) (*types.Transaction, bool) {
+	if tx, ok := c.txs.Get(hash); ok {
+		return recordHit(tx.(*types.Transaction))
+	}
+
+	return recordMiss[*types.Transaction]()
+}
+
+// transactionInBlock looks up a transaction by its block hash and index in the block.
+func (c *l1Cache) transactionInBlock(blockHash common.Hash, index uint) (*types.Transaction, bool) {
+	if block, ok := c.blocks.Get(blockHash); ok {
+		if txs := block.(*types.Block).Transactions(); index < uint(len(txs)) {
+			return recordHit(txs[index])
+		}
+	}
+
+	return recordMiss[*types.Transaction]()
+}
+
+// receipt looks up a receipt by its transaction hash.
+func (c *l1Cache) receipt(txHash common.Hash) (*types.Receipt, bool) {
+	if receipt, ok := c.receipts.Get(txHash); ok {
+		return recordHit(receipt.(*types.Receipt))
+	}
+
+	return recordMiss[*types.Receipt]()
+}
+
+// addHeader caches the given header, and its height to hash mapping if it's final.
+func (c *l1Cache) addHeader(header *types.Header) {
+	c.headers.Add(header.Hash(), header)
+	if c.isFinal(header.Number.Uint64()) {
+		c.hashes.Add(header.Number.Uint64(), header.Hash())
+	}
+}
+
+// addBlock caches the given block, and its height to hash mapping if it's final.
+func (c *l1Cache) addBlock(block *types.Block) {
+	c.blocks.Add(block.Hash(), block)
+	if c.isFinal(block.NumberU64()) {
+		c.hashes.Add(block.NumberU64(), block.Hash())
+	}
+}
+
+// addTransaction caches the given transaction.
+func (c *l1Cache) addTransaction(tx *types.Transaction) {
+	c.txs.Add(tx.Hash(), tx)
+}
+
+// addReceipt caches the given receipt if its block is final.
+func (c *l1Cache) addReceipt(receipt *types.Receipt) {
+	if receipt.BlockNumber != nil && c.isFinal(receipt.BlockNumber.Uint64()) {
+		c.receipts.Add(receipt.TxHash, receipt)
+	}
+}
+
+// recordHit records a cache hit and returns the cached value.
+func recordHit[T any](value T) (T, bool) {
+	metrics.RPCL1CacheHitCounter.Inc(1)
+	return value, true
+}
+
+// recordMiss records a cache miss.
+func recordMiss[T any]() (T, bool) {
+	metrics.RPCL1CacheMissCounter.Inc(1)
+	var empty T
+	return empty, false
+}
@@ -0,0 +1,85 @@
+package rpc
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestHeader(number uint64) *types.Header {
+	return &types.Header{Number: new(big.Int).SetUint64(number), Difficulty: common.Big0}
+}
+
+func TestL1CacheHeaders(t *testing.T) {
+	cache := newL1Cache(DefaultL1CacheSize)
+	cache.setHead(100)
+
+	final := newTestHeader(100 - l1CacheFinalityDepth)
+	unsafe := newTestHeader(100 - l1CacheFinalityDepth + 1)
+	cache.addHeader(final)
+	cache.addHeader(unsafe)
+
+	// Headers are always cached by hash.
+	header, ok := cache.headerByHash(final.Hash())
+	require.True(t, ok)
+	require.Equal(t, final, header)
+	header, ok = cache.headerByHash(unsafe.Hash())
+	require.True(t, ok)
+	require.Equal(t, unsafe, header)
+
+	// Only final headers are cached by height.
+	header, ok = cache.headerByNumber(final.Number)
+	require.True(t, ok)
+	require.Equal(t, final, header)
+	_, ok = cache.headerByNumber(unsafe.Number)
+	require.False(t, ok)
+	_, ok = cache.headerByNumber(nil)
+	require.False(t, ok)
+
+	// Heights which are not final anymore should be invalidated after the head goes backwards.
+	cache.setHead(99)
+	_, ok = cache.headerByNumber(final.Number)
+	require.False(t, ok)
+	_, ok = cache.headerByHash(final.Hash())
+	require.True(t, ok)
+}
+
+func TestL1CacheBlocksAndReceipts(t *testing.T) {
+	cache := newL1Cache(DefaultL1CacheSize)
+	cache.setHead(100)
+
+	tx := types.NewTransaction(0, common.Address{}, common.Big0, 21000, common.Big1, nil)
+	block := types.NewBlockWithHeader(newTestHeader(1)).WithBody([]*types.Transaction{tx}, nil)
+	cache.addBlock(block)
+
+	cached, ok := cache.blockByNumber(common.Big1)
+	require.True(t, ok)
+	require.Equal(t, block.Hash(), cached.Hash())
+
+	header, ok := cache.headerByNumber(common.Big1)
+	require.True(t, ok)
+	require.Equal(t, block.Hash(), header.Hash())
+
+	cachedTx, ok := cache.transactionInBlock(block.Hash(), 0)
+	require.True(t, ok)
+	require.Equal(t, tx.Hash(), cachedTx.Hash())
+	_, ok = cache.transactionInBlock(block.Hash(), 1)
+	require.False(t, ok)
+
+	// Only receipts of final blocks are cached.
+	cache.addReceipt(&types.Receipt{TxHash: tx.Hash(), BlockNumber: common.Big1})
+	_, ok = cache.receipt(tx.Hash())
+	require.True(t, ok)
+
+	unsafeTxHash := common.HexToHash("0x1")
+	cache.addReceipt(&types.Receipt{TxHash: unsafeTxHash, BlockNumber: big.NewInt(100)})
+	_, ok = cache.receipt(unsafeTxHash)
+	require.False(t, ok)
+
+	cache.setHead(l1CacheFinalityDepth)
+	_, ok = cache.receipt(tx.Hash())
+	require.False(t, ok)
+}
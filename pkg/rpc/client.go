@@ -3,21 +3,24 @@ package rpc
 import (
 	"context"
 	"math/big"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/taikoxyz/taiko-client/bindings"
+	"github.com/taikoxyz/taiko-client/metrics"
 )
 
 // Client contains all L1/L2 RPC clients that a driver needs.
 type Client struct {
-	// Geth ethclient clients
-	L1 *ethclient.Client
-	L2 *ethclient.Client
-	// Geth raw RPC clients
-	L1RawRPC *rpc.Client
-	L2RawRPC *rpc.Client
+	// Geth ethclient clients, the L1 client fails over between the L1 endpoints, both of them
+	// are rate limited.
+	L1 *FailoverClient
+	L2 *L2Client
+	// Geth raw RPC clients, which are the L1 and L2 clients above, so that the raw calls are also
+	// failed over and rate limited.
+	L1RawRPC RawRPCClient
+	L2RawRPC RawRPCClient
 	// Geth Engine API clients
 	L2Engine *EngineClient
 	// Protocol contracts clients
@@ -26,48 +29,70 @@ type Client struct {
 	// Chain IDs
 	L1ChainID *big.Int
 	L2ChainID *big.Int
+	// Whether the L2 endpoint is an HTTP endpoint, which doesn't support subscriptions.
+	l2Polling bool
+}
+
+// RawRPCClient is a raw JSON-RPC client, e.g. a geth rpc.Client.
+type RawRPCClient interface {
+	CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error
+	BatchCallContext(ctx context.Context, b []rpc.BatchElem) error
 }
 
 // ClientConfig contains all configs which will be used to initializing an
 // RPC client. If not providing L2EngineEndpoint or JwtSecret, then the L2Engine client
-// won't be initialized.
+// won't be initialized. The L1 client will fail over to L1FallbackEndpoints in order, when
+// L1Endpoint is unhealthy or lagging behind. For HTTP endpoints, subscriptions will be
+// replaced by polling. The rate limits are in requests per second, zero means unlimited.
 type ClientConfig struct {
-	L1Endpoint       string
-	L2Endpoint       string
-	TaikoL1Address   common.Address
-	TaikoL2Address   common.Address
-	L2EngineEndpoint string
-	JwtSecret        string
+	L1Endpoint            string
+	L1FallbackEndpoints   []string
+	L1HealthCheckInterval time.Duration
+	L1MaxHeadLag          uint64
+	L1RateLimit           uint64
+	L1LogsRateLimit       uint64
+	L2Endpoint            string
+	L2RateLimit           uint64
+	L2LogsRateLimit       uint64
+	TaikoL1Address        common.Address
+	TaikoL2Address        common.Address
+	L2EngineEndpoint      string
+	JwtSecret             string
 }
 
 // NewClient initializes all RPC clients used by Taiko client softwares.
 func NewClient(ctx context.Context, cfg *ClientConfig) (*Client, error) {
-	l1RPC, err := DialClientWithBackoff(ctx, cfg.L1Endpoint)
-	if err != nil {
-		return nil, err
-	}
-
-	taikoL1, err := bindings.NewTaikoL1Client(cfg.TaikoL1Address, l1RPC)
-	if err != nil {
-		return nil, err
+	l1MaxHeadLag := cfg.L1MaxHeadLag
+	if l1MaxHeadLag == 0 {
+		l1MaxHeadLag = DefaultMaxHeadLag
 	}
 
-	l2RPC, err := DialClientWithBackoff(ctx, cfg.L2Endpoint)
+	l1RPC, err := DialFailoverClientWithBackoff(
+		ctx,
+		append([]string{cfg.L1Endpoint}, cfg.L1FallbackEndpoints...),
+		cfg.L1HealthCheckInterval,
+		l1MaxHeadLag,
+		NewRateLimiter(cfg.L1RateLimit, cfg.L1LogsRateLimit, metrics.RPCL1ThrottledCounter),
+	)
 	if err != nil {
 		return nil, err
 	}
 
-	taikoL2, err := bindings.NewTaikoL2Client(cfg.TaikoL2Address, l2RPC)
+	taikoL1, err := bindings.NewTaikoL1Client(cfg.TaikoL1Address, l1RPC)
 	if err != nil {
 		return nil, err
 	}
 
-	l1RawRPC, err := rpc.Dial(cfg.L1Endpoint)
+	l2RawClient, err := DialRawClientWithBackoff(ctx, cfg.L2Endpoint)
 	if err != nil {
 		return nil, err
 	}
+	l2RPC := NewL2Client(
+		l2RawClient,
+		NewRateLimiter(cfg.L2RateLimit, cfg.L2LogsRateLimit, metrics.RPCL2ThrottledCounter),
+	)
 
-	l2RawRPC, err := rpc.Dial(cfg.L2Endpoint)
+	taikoL2, err := bindings.NewTaikoL2Client(cfg.TaikoL2Address, l2RPC)
 	if err != nil {
 		return nil, err
 	}
@@ -95,13 +120,14 @@ func NewClient(ctx context.Context, cfg *ClientConfig) (*Client, error) {
 	client := &Client{
 		L1:        l1RPC,
 		L2:        l2RPC,
-		L1RawRPC:  l1RawRPC,
-		L2RawRPC:  l2RawRPC,
+		L1RawRPC:  l1RPC,
+		L2RawRPC:  l2RPC,
 		L2Engine:  l2AuthRPC,
 		TaikoL1:   taikoL1,
 		TaikoL2:   taikoL2,
 		L1ChainID: l1ChainID,
 		L2ChainID: l2ChainID,
+		l2Polling: IsHTTPEndpoint(cfg.L2Endpoint),
 	}
 
 	if err := client.ensureGenesisMatched(ctx); err != nil {
@@ -15,10 +15,21 @@ import (
 // DialClientWithBackoff connects a ethereum RPC client at the given URL with
 // a backoff strategy.
 func DialClientWithBackoff(ctx context.Context, url string) (*ethclient.Client, error) {
-	var client *ethclient.Client
+	client, err := DialRawClientWithBackoff(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	return ethclient.NewClient(client), nil
+}
+
+// DialRawClientWithBackoff connects a raw ethereum RPC client at the given URL with
+// a backoff strategy.
+func DialRawClientWithBackoff(ctx context.Context, url string) (*rpc.Client, error) {
+	var client *rpc.Client
 	if err := backoff.Retry(
 		func() (err error) {
-			client, err = ethclient.DialContext(ctx, url)
+			client, err = rpc.DialContext(ctx, url)
 			return err
 		},
 		backoff.NewExponentialBackOff(),
@@ -0,0 +1,836 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/taikoxyz/taiko-client/metrics"
+)
+
+const (
+	// Default interval between two health checks of all endpoints.
+	DefaultHealthCheckInterval = 10 * time.Second
+	// Default maximum number of blocks that the primary endpoint's head can lag behind the
+	// highest head of all healthy endpoints.
+	DefaultMaxHeadLag = 5
+	// Timeout of a single health check request.
+	healthCheckTimeout = 5 * time.Second
+	// Maximum backoff of re-establishing a subscription.
+	resubscribeBackoffMax = 10 * time.Second
+)
+
+// errPrimarySwitched is sent by the subscriptions on the previous primary endpoint, to re-establish
+// them on the new one.
+var errPrimarySwitched = errors.New("primary endpoint switched")
+
+// failoverEndpoint represents one of the endpoints of a FailoverClient.
+type failoverEndpoint struct {
+	url       string
+	rawClient *rpc.Client       // nil if not connected yet
+	client    *ethclient.Client // ethclient.Client over rawClient
+	healthy   bool
+	head      uint64
+}
+
+// FailoverClient is an ethclient.Client compatible client over an ordered list of endpoints of the
+// same chain. It sends all requests to the primary endpoint, which is the first healthy endpoint
+// whose head does not lag behind, and switches to the next healthy one transparently when the
+// primary endpoint fails. Subscriptions are re-established on the new primary endpoint after
+// switching, events emitted in the meantime might be missed. Headers, blocks, transactions and
+// receipts are cached, see l1Cache for details.
+type FailoverClient struct {
+	endpoints  []*failoverEndpoint
+	primary    int
+	chainID    *big.Int
+	maxHeadLag uint64
+	// switched is closed when the primary endpoint is switched.
+	switched chan struct{}
+	// Cache of the immutable or final chain data, shared by all endpoints.
+	cache *l1Cache
+	// Rate limiter shared by all endpoints, nil if unlimited.
+	limiter *RateLimiter
+	mutex   sync.RWMutex
+}
+
+// DialFailoverClientWithBackoff connects all the given endpoints, retries with a backoff strategy until
+// at least one of them is connected. The endpoints which are not connected yet will be connected in
+// the health checks, which keep running until the given context is done. The health checks are not
+// rate limited by the given limiter.
+func DialFailoverClientWithBackoff(
+	ctx context.Context,
+	urls []string,
+	healthCheckInterval time.Duration,
+	maxHeadLag uint64,
+	limiter *RateLimiter,
+) (*FailoverClient, error) {
+	if len(urls) == 0 {
+		return nil, errors.New("no endpoints to dial")
+	}
+
+	endpoints := make([]*failoverEndpoint, 0, len(urls))
+	for _, url := range urls {
+		endpoints = append(endpoints, &failoverEndpoint{url: url})
+	}
+
+	c := newFailoverClient(endpoints, maxHeadLag, limiter)
+	if err := backoff.Retry(
+		func() error {
+			c.checkHealth(ctx)
+			if !c.hasHealthyEndpoint() {
+				return fmt.Errorf("failed to connect any of the %d endpoints", len(urls))
+			}
+			return nil
+		},
+		backoff.WithContext(backoff.NewExponentialBackOff(), ctx),
+	); err != nil {
+		return nil, err
+	}
+
+	if healthCheckInterval == 0 {
+		healthCheckInterval = DefaultHealthCheckInterval
+	}
+	go c.healthCheckLoop(ctx, healthCheckInterval)
+
+	return c, nil
+}
+
+// newFailoverClient creates a new failover client instance over the given endpoints.
+func newFailoverClient(endpoints []*failoverEndpoint, maxHeadLag uint64, limiter *RateLimiter) *FailoverClient {
+	return &FailoverClient{
+		endpoints:  endpoints,
+		maxHeadLag: maxHeadLag,
+		switched:   make(chan struct{}),
+		cache:      newL1Cache(DefaultL1CacheSize),
+		limiter:    limiter,
+	}
+}
+
+// healthCheckLoop keeps checking all endpoints' health, until the given context is done.
+func (c *FailoverClient) healthCheckLoop(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			c.checkHealth(ctx)
+		}
+	}
+}
+
+// checkHealth connects the endpoints which are not connected yet, fetches the heads of all endpoints,
+// and then selects the primary endpoint.
+func (c *FailoverClient) checkHealth(ctx context.Context) {
+	type result struct {
+		rawClient *rpc.Client
+		head      uint64
+		err       error
+	}
+
+	c.mutex.RLock()
+	endpoints := make([]failoverEndpoint, len(c.endpoints))
+	for i, e := range c.endpoints {
+		endpoints[i] = *e
+	}
+	c.mutex.RUnlock()
+
+	results := make([]result, len(endpoints))
+	for i, e := range endpoints {
+		ctxWithTimeout, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+		results[i].rawClient, results[i].head, results[i].err = c.checkEndpoint(ctxWithTimeout, e)
+		cancel()
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	for i, e := range c.endpoints {
+		if results[i].rawClient != nil && e.rawClient == nil {
+			e.rawClient, e.client = results[i].rawClient, ethclient.NewClient(results[i].rawClient)
+		}
+
+		if results[i].err != nil {
+			if e.healthy {
+				log.Warn("Endpoint health check failed", "url", e.url, "error", results[i].err)
+			}
+			e.healthy = false
+			continue
+		}
+
+		e.head, e.healthy = results[i].head, true
+	}
+
+	var highest uint64
+	for _, e := range c.endpoints {
+		if e.healthy && e.head > highest {
+			highest = e.head
+		}
+	}
+	if highest != 0 {
+		c.cache.setHead(highest)
+	}
+
+	if primary := selectPrimaryEndpoint(c.endpoints, c.primary, c.maxHeadLag); primary != c.primary {
+		c.switchPrimary(primary, "health check")
+	}
+}
+
+// checkEndpoint connects the given endpoint if it is not connected yet, and fetches its head.
+func (c *FailoverClient) checkEndpoint(
+	ctx context.Context,
+	e failoverEndpoint,
+) (rawClient *rpc.Client, head uint64, err error) {
+	client := e.client
+	if rawClient = e.rawClient; rawClient == nil {
+		if rawClient, err = rpc.DialContext(ctx, e.url); err != nil {
+			return nil, 0, err
+		}
+		client = ethclient.NewClient(rawClient)
+
+		chainID, err := client.ChainID(ctx)
+		if err != nil {
+			client.Close()
+			return nil, 0, err
+		}
+
+		// All endpoints must serve the same chain.
+		c.mutex.Lock()
+		if c.chainID == nil {
+			c.chainID = chainID
+		}
+		expected := c.chainID
+		c.mutex.Unlock()
+
+		if chainID.Cmp(expected) != 0 {
+			client.Close()
+			return nil, 0, fmt.Errorf("chain ID mismatch, expected: %s, actual: %s", expected, chainID)
+		}
+	}
+
+	if head, err = client.BlockNumber(ctx); err != nil {
+		return rawClient, 0, err
+	}
+
+	return rawClient, head, nil
+}
+
+// selectPrimaryEndpoint returns the index of the first healthy endpoint whose head doesn't lag behind
+// the highest head of all healthy endpoints for more than maxHeadLag blocks, or the current index if
+// there is no healthy endpoint.
+func selectPrimaryEndpoint(endpoints []*failoverEndpoint, current int, maxHeadLag uint64) int {
+	var highest uint64
+	for _, e := range endpoints {
+		if e.healthy && e.head > highest {
+			highest = e.head
+		}
+	}
+
+	for i, e := range endpoints {
+		if e.healthy && e.head+maxHeadLag >= highest {
+			return i
+		}
+	}
+
+	return current
+}
+
+// switchPrimary switches the primary endpoint to the endpoint with the given index, and notifies all
+// subscriptions to re-establish on the new primary endpoint.
+func (c *FailoverClient) switchPrimary(primary int, reason string) {
+	log.Warn(
+		"Switch primary endpoint",
+		"from", c.endpoints[c.primary].url,
+		"to", c.endpoints[primary].url,
+		"reason", reason,
+	)
+
+	c.primary = primary
+	close(c.switched)
+	c.switched = make(chan struct{})
+	metrics.RPCEndpointSwitchedCounter.Inc(1)
+}
+
+// primaryURL returns the URL of the primary endpoint.
+func (c *FailoverClient) primaryURL() string {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	return c.endpoints[c.primary].url
+}
+
+// hasHealthyEndpoint checks whether there is at least one healthy endpoint.
+func (c *FailoverClient) hasHealthyEndpoint() bool {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	for _, e := range c.endpoints {
+		if e.healthy {
+			return true
+		}
+	}
+
+	return false
+}
+
+// current returns the primary endpoint with its client, which is nil if the endpoint is not connected
+// yet, and a channel which will be closed when the primary endpoint is switched.
+func (c *FailoverClient) current() (*failoverEndpoint, *ethclient.Client, <-chan struct{}) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	e := c.endpoints[c.primary]
+	return e, e.client, c.switched
+}
+
+// onEndpointFailed marks the given endpoint as unhealthy, and switches the primary endpoint to the
+// next healthy one if the given endpoint is the primary endpoint.
+func (c *FailoverClient) onEndpointFailed(e *failoverEndpoint, err error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	e.healthy = false
+	if c.endpoints[c.primary] != e {
+		return
+	}
+
+	for i := 1; i < len(c.endpoints); i++ {
+		next := (c.primary + i) % len(c.endpoints)
+		if c.endpoints[next].healthy {
+			c.switchPrimary(next, err.Error())
+			return
+		}
+	}
+
+	log.Error("No healthy endpoint to switch to", "url", e.url, "error", err)
+}
+
+// isEndpointError checks whether the given error is caused by the endpoint itself, e.g. the connection
+// is lost, rather than by the request.
+func isEndpointError(err error) bool {
+	if err == nil ||
+		errors.Is(err, context.Canceled) ||
+		errors.Is(err, context.DeadlineExceeded) ||
+		errors.Is(err, ethereum.NotFound) ||
+		errors.Is(err, rpc.ErrNotificationsUnsupported) {
+		return false
+	}
+
+	// The endpoint has responded with a JSON-RPC error.
+	var rpcErr rpc.Error
+	return !errors.As(err, &rpcErr)
+}
+
+// do waits for the rate limiter, then calls the given function with the primary endpoint's client,
+// if the call fails because of the endpoint, switches to the next healthy endpoint and retries.
+func (c *FailoverClient) do(ctx context.Context, method string, fn func(client *ethclient.Client) error) error {
+	if err := c.limiter.Wait(ctx, method); err != nil {
+		return err
+	}
+
+	return c.doWithEndpoint(func(_ *failoverEndpoint, client *ethclient.Client, _ <-chan struct{}) error {
+		return fn(client)
+	})
+}
+
+// doWithEndpoint is like do, but also passes the primary endpoint and its switched channel to
+// the given function.
+func (c *FailoverClient) doWithEndpoint(
+	fn func(e *failoverEndpoint, client *ethclient.Client, switched <-chan struct{}) error,
+) error {
+	var err error
+	for i := 0; i < len(c.endpoints); i++ {
+		e, client, switched := c.current()
+		if client == nil {
+			err = fmt.Errorf("endpoint not connected: %s", e.url)
+		} else if err = fn(e, client, switched); !isEndpointError(err) {
+			return err
+		}
+
+		c.onEndpointFailed(e, err)
+	}
+
+	return err
+}
+
+// doWithRawClient is like do, but calls the given function with the primary endpoint's raw RPC
+// client, and doesn't wait for the rate limiter.
+func (c *FailoverClient) doWithRawClient(fn func(client *rpc.Client) error) error {
+	return c.doWithEndpoint(func(e *failoverEndpoint, _ *ethclient.Client, _ <-chan struct{}) error {
+		c.mutex.RLock()
+		rawClient := e.rawClient
+		c.mutex.RUnlock()
+
+		return fn(rawClient)
+	})
+}
+
+// failoverCall calls the given function through the given failover client, and returns its result.
+func failoverCall[T any](
+	ctx context.Context,
+	c *FailoverClient,
+	method string,
+	fn func(client *ethclient.Client) (T, error),
+) (T, error) {
+	var result T
+	err := c.do(ctx, method, func(client *ethclient.Client) (err error) {
+		result, err = fn(client)
+		return err
+	})
+	return result, err
+}
+
+// resubscribe establishes the subscription on the primary endpoint, and re-establishes it on the
+// new primary endpoint whenever the primary endpoint is switched or the subscription fails.
+func (c *FailoverClient) resubscribe(
+	ctx context.Context,
+	subscribe func(ctx context.Context, url string, client *ethclient.Client) (ethereum.Subscription, error),
+) (ethereum.Subscription, error) {
+	var (
+		endpoint *failoverEndpoint
+		first    *failoverSubscription
+	)
+	if err := c.doWithEndpoint(func(e *failoverEndpoint, client *ethclient.Client, switched <-chan struct{}) error {
+		sub, err := subscribe(ctx, e.url, client)
+		if err != nil {
+			return err
+		}
+
+		endpoint, first = e, newFailoverSubscription(sub, switched)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	resubscribeFn := func(ctx context.Context, lastErr error) (event.Subscription, error) {
+		if first != nil {
+			sub := first
+			first = nil
+			return sub, nil
+		}
+
+		// The subscription failed because of the endpoint, rather than switching.
+		if lastErr != nil && !errors.Is(lastErr, errPrimarySwitched) {
+			c.onEndpointFailed(endpoint, lastErr)
+		}
+
+		e, client, switched := c.current()
+		if client == nil {
+			return nil, fmt.Errorf("endpoint not connected: %s", e.url)
+		}
+
+		sub, err := subscribe(ctx, e.url, client)
+		if err != nil {
+			if isEndpointError(err) {
+				c.onEndpointFailed(e, err)
+			}
+			return nil, err
+		}
+
+		endpoint = e
+		return newFailoverSubscription(sub, switched), nil
+	}
+
+	return event.ResubscribeErr(resubscribeBackoffMax, resubscribeFn), nil
+}
+
+// failoverSubscription wraps a subscription on an endpoint, which fails when the primary
+// endpoint is switched.
+type failoverSubscription struct {
+	err       chan error
+	unsub     chan struct{}
+	unsubOnce sync.Once
+}
+
+// newFailoverSubscription creates a new failover subscription instance.
+func newFailoverSubscription(sub ethereum.Subscription, switched <-chan struct{}) *failoverSubscription {
+	s := &failoverSubscription{err: make(chan error, 1), unsub: make(chan struct{})}
+
+	go func() {
+		defer sub.Unsubscribe()
+
+		select {
+		case err := <-sub.Err():
+			s.err <- err
+		case <-switched:
+			s.err <- errPrimarySwitched
+		case <-s.unsub:
+		}
+	}()
+
+	return s
+}
+
+// Err implements the event.Subscription interface.
+func (s *failoverSubscription) Err() <-chan error {
+	return s.err
+}
+
+// Unsubscribe implements the event.Subscription interface.
+func (s *failoverSubscription) Unsubscribe() {
+	s.unsubOnce.Do(func() { close(s.unsub) })
+}
+
+// ChainID retrieves the current chain ID for transaction replay protection.
+func (c *FailoverClient) ChainID(ctx context.Context) (*big.Int, error) {
+	return failoverCall(ctx, c, "eth_chainId", func(client *ethclient.Client) (*big.Int, error) {
+		return client.ChainID(ctx)
+	})
+}
+
+// BlockByHash returns the given full block.
+func (c *FailoverClient) BlockByHash(ctx context.Context, hash common.Hash) (*types.Block, error) {
+	if block, ok := c.cache.blockByHash(hash); ok {
+		return block, nil
+	}
+
+	block, err := failoverCall(ctx, c, "eth_getBlockByHash", func(client *ethclient.Client) (*types.Block, error) {
+		return client.BlockByHash(ctx, hash)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	c.cache.addBlock(block)
+	return block, nil
+}
+
+// BlockByNumber returns a block from the current canonical chain. If number is nil, the
+// latest known block is returned.
+func (c *FailoverClient) BlockByNumber(ctx context.Context, number *big.Int) (*types.Block, error) {
+	if block, ok := c.cache.blockByNumber(number); ok {
+		return block, nil
+	}
+
+	// Head tracking requests should not be starved by the backfill requests.
+	if number == nil {
+		ctx = WithRequestPriority(ctx, RequestPriorityHigh)
+	}
+
+	block, err := failoverCall(ctx, c, "eth_getBlockByNumber", func(client *ethclient.Client) (*types.Block, error) {
+		return client.BlockByNumber(ctx, number)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if number == nil {
+		c.cache.setHead(block.NumberU64())
+	}
+	c.cache.addBlock(block)
+	return block, nil
+}
+
+// BlockNumber returns the most recent block number.
+func (c *FailoverClient) BlockNumber(ctx context.Context) (uint64, error) {
+	ctx = WithRequestPriority(ctx, RequestPriorityHigh)
+	return failoverCall(ctx, c, "eth_blockNumber", func(client *ethclient.Client) (uint64, error) {
+		return client.BlockNumber(ctx)
+	})
+}
+
+// HeaderByHash returns the block header with the given hash.
+func (c *FailoverClient) HeaderByHash(ctx context.Context, hash common.Hash) (*types.Header, error) {
+	if header, ok := c.cache.headerByHash(hash); ok {
+		return header, nil
+	}
+
+	header, err := failoverCall(ctx, c, "eth_getBlockByHash", func(client *ethclient.Client) (*types.Header, error) {
+		return client.HeaderByHash(ctx, hash)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	c.cache.addHeader(header)
+	return header, nil
+}
+
+// HeaderByNumber returns a block header from the current canonical chain. If number is
+// nil, the latest known header is returned.
+func (c *FailoverClient) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	if header, ok := c.cache.headerByNumber(number); ok {
+		return header, nil
+	}
+
+	// Head tracking requests should not be starved by the backfill requests.
+	if number == nil {
+		ctx = WithRequestPriority(ctx, RequestPriorityHigh)
+	}
+
+	header, err := failoverCall(ctx, c, "eth_getBlockByNumber", func(client *ethclient.Client) (*types.Header, error) {
+		return client.HeaderByNumber(ctx, number)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if number == nil {
+		c.cache.setHead(header.Number.Uint64())
+	}
+	c.cache.addHeader(header)
+	return header, nil
+}
+
+// TransactionByHash returns the transaction with the given hash.
+func (c *FailoverClient) TransactionByHash(
+	ctx context.Context,
+	hash common.Hash,
+) (tx *types.Transaction, isPending bool, err error) {
+	if tx, ok := c.cache.transactionByHash(hash); ok {
+		return tx, false, nil
+	}
+
+	if err = c.do(ctx, "eth_getTransactionByHash", func(client *ethclient.Client) (err error) {
+		tx, isPending, err = client.TransactionByHash(ctx, hash)
+		return err
+	}); err != nil {
+		return nil, false, err
+	}
+
+	// Only the included transactions are cached.
+	if !isPending {
+		c.cache.addTransaction(tx)
+	}
+	return tx, isPending, nil
+}
+
+// TransactionInBlock returns a single transaction at index in the given block.
+func (c *FailoverClient) TransactionInBlock(
+	ctx context.Context,
+	blockHash common.Hash,
+	index uint,
+) (*types.Transaction, error) {
+	if tx, ok := c.cache.transactionInBlock(blockHash, index); ok {
+		return tx, nil
+	}
+
+	tx, err := failoverCall(
+		ctx,
+		c,
+		"eth_getTransactionByBlockHashAndIndex",
+		func(client *ethclient.Client) (*types.Transaction, error) {
+			return client.TransactionInBlock(ctx, blockHash, index)
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	c.cache.addTransaction(tx)
+	return tx, nil
+}
+
+// TransactionReceipt returns the receipt of a transaction by transaction hash.
+func (c *FailoverClient) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	if receipt, ok := c.cache.receipt(txHash); ok {
+		return receipt, nil
+	}
+
+	receipt, err := failoverCall(
+		ctx,
+		c,
+		"eth_getTransactionReceipt",
+		func(client *ethclient.Client) (*types.Receipt, error) {
+			return client.TransactionReceipt(ctx, txHash)
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	c.cache.addReceipt(receipt)
+	return receipt, nil
+}
+
+// SubscribeNewHead subscribes to notifications about the current blockchain head, the subscription
+// will be re-established on the new primary endpoint after switching. For HTTP endpoints, the new
+// heads will be polled instead.
+func (c *FailoverClient) SubscribeNewHead(
+	ctx context.Context,
+	ch chan<- *types.Header,
+) (ethereum.Subscription, error) {
+	return c.resubscribe(
+		ctx,
+		func(ctx context.Context, url string, client *ethclient.Client) (ethereum.Subscription, error) {
+			if IsHTTPEndpoint(url) {
+				return SubscribeNewHeadByPolling(c, DefaultPollingInterval, ch), nil
+			}
+			return client.SubscribeNewHead(ctx, ch)
+		},
+	)
+}
+
+// BalanceAt returns the wei balance of the given account.
+func (c *FailoverClient) BalanceAt(
+	ctx context.Context,
+	account common.Address,
+	blockNumber *big.Int,
+) (*big.Int, error) {
+	return failoverCall(ctx, c, "eth_getBalance", func(client *ethclient.Client) (*big.Int, error) {
+		return client.BalanceAt(ctx, account, blockNumber)
+	})
+}
+
+// CodeAt returns the contract code of the given account.
+func (c *FailoverClient) CodeAt(ctx context.Context, account common.Address, blockNumber *big.Int) ([]byte, error) {
+	return failoverCall(ctx, c, "eth_getCode", func(client *ethclient.Client) ([]byte, error) {
+		return client.CodeAt(ctx, account, blockNumber)
+	})
+}
+
+// NonceAt returns the account nonce of the given account.
+func (c *FailoverClient) NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error) {
+	return failoverCall(ctx, c, "eth_getTransactionCount", func(client *ethclient.Client) (uint64, error) {
+		return client.NonceAt(ctx, account, blockNumber)
+	})
+}
+
+// FilterLogs executes a filter query.
+func (c *FailoverClient) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+	return failoverCall(ctx, c, "eth_getLogs", func(client *ethclient.Client) ([]types.Log, error) {
+		return client.FilterLogs(ctx, q)
+	})
+}
+
+// SubscribeFilterLogs subscribes to the results of a streaming filter query, the subscription
+// will be re-established on the new primary endpoint after switching. For HTTP endpoints, the logs
+// will be polled instead.
+func (c *FailoverClient) SubscribeFilterLogs(
+	ctx context.Context,
+	q ethereum.FilterQuery,
+	ch chan<- types.Log,
+) (ethereum.Subscription, error) {
+	return c.resubscribe(
+		ctx,
+		func(ctx context.Context, url string, client *ethclient.Client) (ethereum.Subscription, error) {
+			if IsHTTPEndpoint(url) {
+				return SubscribeFilterLogsByPolling(c, q, DefaultPollingInterval, ch), nil
+			}
+			return client.SubscribeFilterLogs(ctx, q, ch)
+		},
+	)
+}
+
+// PendingCodeAt returns the contract code of the given account in the pending state.
+func (c *FailoverClient) PendingCodeAt(ctx context.Context, account common.Address) ([]byte, error) {
+	return failoverCall(ctx, c, "eth_getCode", func(client *ethclient.Client) ([]byte, error) {
+		return client.PendingCodeAt(ctx, account)
+	})
+}
+
+// PendingNonceAt returns the account nonce of the given account in the pending state.
+func (c *FailoverClient) PendingNonceAt(ctx context.Context, account common.Address) (uint64, error) {
+	return failoverCall(ctx, c, "eth_getTransactionCount", func(client *ethclient.Client) (uint64, error) {
+		return client.PendingNonceAt(ctx, account)
+	})
+}
+
+// CallContract executes a message call transaction, which is directly executed in the VM
+// of the node, but never mined into the blockchain.
+func (c *FailoverClient) CallContract(
+	ctx context.Context,
+	msg ethereum.CallMsg,
+	blockNumber *big.Int,
+) ([]byte, error) {
+	return failoverCall(ctx, c, "eth_call", func(client *ethclient.Client) ([]byte, error) {
+		return client.CallContract(ctx, msg, blockNumber)
+	})
+}
+
+// PendingCallContract executes a message call transaction using the EVM, the state seen
+// by the contract call is the pending state.
+func (c *FailoverClient) PendingCallContract(ctx context.Context, msg ethereum.CallMsg) ([]byte, error) {
+	return failoverCall(ctx, c, "eth_call", func(client *ethclient.Client) ([]byte, error) {
+		return client.PendingCallContract(ctx, msg)
+	})
+}
+
+// SuggestGasPrice retrieves the currently suggested gas price to allow a timely
+// execution of a transaction.
+func (c *FailoverClient) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
+	return failoverCall(ctx, c, "eth_gasPrice", func(client *ethclient.Client) (*big.Int, error) {
+		return client.SuggestGasPrice(ctx)
+	})
+}
+
+// SuggestGasTipCap retrieves the currently suggested gas tip cap after 1559 to
+// allow a timely execution of a transaction.
+func (c *FailoverClient) SuggestGasTipCap(ctx context.Context) (*big.Int, error) {
+	return failoverCall(ctx, c, "eth_maxPriorityFeePerGas", func(client *ethclient.Client) (*big.Int, error) {
+		return client.SuggestGasTipCap(ctx)
+	})
+}
+
+// EstimateGas tries to estimate the gas needed to execute a specific transaction based on
+// the current pending state of the backend blockchain.
+func (c *FailoverClient) EstimateGas(ctx context.Context, msg ethereum.CallMsg) (uint64, error) {
+	return failoverCall(ctx, c, "eth_estimateGas", func(client *ethclient.Client) (uint64, error) {
+		return client.EstimateGas(ctx, msg)
+	})
+}
+
+// SendTransaction injects a signed transaction into the pending pool for execution.
+func (c *FailoverClient) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	ctx = WithRequestPriority(ctx, RequestPriorityHigh)
+	return c.do(ctx, "eth_sendRawTransaction", func(client *ethclient.Client) error {
+		return client.SendTransaction(ctx, tx)
+	})
+}
+
+// CallContext performs a raw JSON-RPC call with the given arguments on the primary endpoint, and
+// fails over like the other requests. The result is not cached.
+func (c *FailoverClient) CallContext(
+	ctx context.Context,
+	result interface{},
+	method string,
+	args ...interface{},
+) error {
+	if err := c.limiter.Wait(ctx, method); err != nil {
+		return err
+	}
+
+	return c.doWithRawClient(func(client *rpc.Client) error {
+		return client.CallContext(ctx, result, method, args...)
+	})
+}
+
+// BatchCallContext sends all given requests as a single batch on the primary endpoint, and fails
+// over like the other requests. The errors of the individual requests are set in the Error fields
+// of the given elements, and don't cause failover. Each request is rate limited individually.
+func (c *FailoverClient) BatchCallContext(ctx context.Context, b []rpc.BatchElem) error {
+	for _, elem := range b {
+		if err := c.limiter.Wait(ctx, elem.Method); err != nil {
+			return err
+		}
+	}
+
+	return c.doWithRawClient(func(client *rpc.Client) error {
+		return client.BatchCallContext(ctx, b)
+	})
+}
+
+// Close closes all connected endpoints.
+func (c *FailoverClient) Close() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	for _, e := range c.endpoints {
+		if e.client != nil {
+			e.client.Close()
+		}
+	}
+}
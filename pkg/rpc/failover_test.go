@@ -0,0 +1,132 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+)
+
+// testEthService is a minimal eth namespace JSON-RPC service for testing.
+type testEthService struct {
+	head uint64
+}
+
+func (s *testEthService) ChainId() *hexutil.Big {
+	return (*hexutil.Big)(big.NewInt(1))
+}
+
+func (s *testEthService) BlockNumber() hexutil.Uint64 {
+	return hexutil.Uint64(s.head)
+}
+
+func newTestFailoverEndpoint(t *testing.T, url string, head uint64) (*failoverEndpoint, *rpc.Server) {
+	server := rpc.NewServer()
+	require.Nil(t, server.RegisterName("eth", &testEthService{head: head}))
+
+	rawClient := rpc.DialInProc(server)
+	return &failoverEndpoint{
+		url:       url,
+		rawClient: rawClient,
+		client:    ethclient.NewClient(rawClient),
+		healthy:   true,
+		head:      head,
+	}, server
+}
+
+func TestSelectPrimaryEndpoint(t *testing.T) {
+	endpoints := []*failoverEndpoint{
+		{url: "a", healthy: true, head: 90},
+		{url: "b", healthy: false, head: 120},
+		{url: "c", healthy: true, head: 100},
+		{url: "d", healthy: true, head: 98},
+	}
+
+	// The first endpoint lags behind too much.
+	require.Equal(t, 2, selectPrimaryEndpoint(endpoints, 0, 5))
+	require.Equal(t, 0, selectPrimaryEndpoint(endpoints, 2, 10))
+
+	endpoints[2].healthy = false
+	require.Equal(t, 3, selectPrimaryEndpoint(endpoints, 2, 5))
+
+	// No healthy endpoint.
+	for _, e := range endpoints {
+		e.healthy = false
+	}
+	require.Equal(t, 1, selectPrimaryEndpoint(endpoints, 1, 5))
+}
+
+func TestIsEndpointError(t *testing.T) {
+	require.False(t, isEndpointError(nil))
+	require.False(t, isEndpointError(context.Canceled))
+	require.False(t, isEndpointError(fmt.Errorf("test: %w", context.DeadlineExceeded)))
+	require.False(t, isEndpointError(ethereum.NotFound))
+	require.False(t, isEndpointError(rpc.ErrNotificationsUnsupported))
+	require.True(t, isEndpointError(errors.New("connection refused")))
+}
+
+func TestFailoverClientSwitchPrimary(t *testing.T) {
+	primary, primaryServer := newTestFailoverEndpoint(t, "primary", 100)
+	fallback, fallbackServer := newTestFailoverEndpoint(t, "fallback", 100)
+	defer fallbackServer.Stop()
+
+	c := newFailoverClient([]*failoverEndpoint{primary, fallback}, DefaultMaxHeadLag, nil)
+	_, _, switched := c.current()
+
+	head, err := c.BlockNumber(context.Background())
+	require.Nil(t, err)
+	require.Equal(t, uint64(100), head)
+	require.Equal(t, "primary", c.primaryURL())
+
+	// Requests should be sent to the fallback endpoint after the primary endpoint fails.
+	primaryServer.Stop()
+
+	head, err = c.BlockNumber(context.Background())
+	require.Nil(t, err)
+	require.Equal(t, uint64(100), head)
+	require.Equal(t, "fallback", c.primaryURL())
+	require.False(t, primary.healthy)
+
+	select {
+	case <-switched:
+	default:
+		t.Fatal("switched channel not closed")
+	}
+}
+
+func TestFailoverClientRawCalls(t *testing.T) {
+	primary, primaryServer := newTestFailoverEndpoint(t, "primary", 100)
+	fallback, fallbackServer := newTestFailoverEndpoint(t, "fallback", 101)
+	defer fallbackServer.Stop()
+
+	c := newFailoverClient([]*failoverEndpoint{primary, fallback}, DefaultMaxHeadLag, nil)
+
+	var head hexutil.Uint64
+	require.Nil(t, c.CallContext(context.Background(), &head, "eth_blockNumber"))
+	require.Equal(t, hexutil.Uint64(100), head)
+
+	// Raw requests should also be sent to the fallback endpoint after the primary endpoint fails.
+	primaryServer.Stop()
+
+	require.Nil(t, c.CallContext(context.Background(), &head, "eth_blockNumber"))
+	require.Equal(t, hexutil.Uint64(101), head)
+	require.Equal(t, "fallback", c.primaryURL())
+
+	var chainID hexutil.Big
+	batch := []rpc.BatchElem{
+		{Method: "eth_blockNumber", Result: &head},
+		{Method: "eth_chainId", Result: &chainID},
+	}
+	require.Nil(t, c.BatchCallContext(context.Background(), batch))
+	require.Nil(t, batch[0].Error)
+	require.Nil(t, batch[1].Error)
+	require.Equal(t, hexutil.Uint64(101), head)
+	require.Equal(t, int64(1), chainID.ToInt().Int64())
+}
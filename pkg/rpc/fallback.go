@@ -3,7 +3,8 @@ package rpc
 import (
 	"errors"
 	"math/big"
-	"strings"
+
+	rpcErrors "github.com/taikoxyz/taiko-client/pkg/rpc_errors"
 )
 
 // Taken from https://github.com/ethereum-optimism/optimism/blob/develop/bss-core/drivers/max_priority_fee_fallback.go
@@ -22,7 +23,5 @@ var (
 // signals that the backend does not support the eth_maxPrirorityFeePerGas
 // method. In this case, the caller should fallback to using the constant above.
 func IsMaxPriorityFeePerGasNotFoundError(err error) bool {
-	return strings.Contains(
-		err.Error(), errMaxPriorityFeePerGasNotFound.Error(),
-	)
+	return errors.Is(rpcErrors.Decode(err), rpcErrors.ErrMethodNotFound)
 }
@@ -0,0 +1,298 @@
+package rpc
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// L2Client is an ethclient.Client compatible client of the L2 execution engine, all requests of
+// which are rate limited, including the raw JSON-RPC calls.
+type L2Client struct {
+	rawClient *rpc.Client
+	client    *ethclient.Client
+	limiter   *RateLimiter
+}
+
+// NewL2Client creates a new L2 client instance over the given raw RPC client, a nil limiter
+// means unlimited.
+func NewL2Client(rawClient *rpc.Client, limiter *RateLimiter) *L2Client {
+	return &L2Client{rawClient: rawClient, client: ethclient.NewClient(rawClient), limiter: limiter}
+}
+
+// limitedCall waits for the rate limiter, then calls the given function.
+func limitedCall[T any](ctx context.Context, l *RateLimiter, method string, fn func() (T, error)) (T, error) {
+	if err := l.Wait(ctx, method); err != nil {
+		var empty T
+		return empty, err
+	}
+
+	return fn()
+}
+
+// ChainID retrieves the current chain ID for transaction replay protection.
+func (c *L2Client) ChainID(ctx context.Context) (*big.Int, error) {
+	return limitedCall(ctx, c.limiter, "eth_chainId", func() (*big.Int, error) {
+		return c.client.ChainID(ctx)
+	})
+}
+
+// BlockByHash returns the given full block.
+func (c *L2Client) BlockByHash(ctx context.Context, hash common.Hash) (*types.Block, error) {
+	return limitedCall(ctx, c.limiter, "eth_getBlockByHash", func() (*types.Block, error) {
+		return c.client.BlockByHash(ctx, hash)
+	})
+}
+
+// BlockByNumber returns a block from the current canonical chain. If number is nil, the
+// latest known block is returned.
+func (c *L2Client) BlockByNumber(ctx context.Context, number *big.Int) (*types.Block, error) {
+	return limitedCall(ctx, c.limiter, "eth_getBlockByNumber", func() (*types.Block, error) {
+		return c.client.BlockByNumber(ctx, number)
+	})
+}
+
+// BlockNumber returns the most recent block number.
+func (c *L2Client) BlockNumber(ctx context.Context) (uint64, error) {
+	ctx = WithRequestPriority(ctx, RequestPriorityHigh)
+	return limitedCall(ctx, c.limiter, "eth_blockNumber", func() (uint64, error) {
+		return c.client.BlockNumber(ctx)
+	})
+}
+
+// HeaderByHash returns the block header with the given hash.
+func (c *L2Client) HeaderByHash(ctx context.Context, hash common.Hash) (*types.Header, error) {
+	return limitedCall(ctx, c.limiter, "eth_getBlockByHash", func() (*types.Header, error) {
+		return c.client.HeaderByHash(ctx, hash)
+	})
+}
+
+// HeaderByNumber returns a block header from the current canonical chain. If number is
+// nil, the latest known header is returned.
+func (c *L2Client) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	// Head tracking requests should not be starved by the backfill requests.
+	if number == nil {
+		ctx = WithRequestPriority(ctx, RequestPriorityHigh)
+	}
+
+	return limitedCall(ctx, c.limiter, "eth_getBlockByNumber", func() (*types.Header, error) {
+		return c.client.HeaderByNumber(ctx, number)
+	})
+}
+
+// TransactionByHash returns the transaction with the given hash.
+func (c *L2Client) TransactionByHash(
+	ctx context.Context,
+	hash common.Hash,
+) (tx *types.Transaction, isPending bool, err error) {
+	if err := c.limiter.Wait(ctx, "eth_getTransactionByHash"); err != nil {
+		return nil, false, err
+	}
+
+	return c.client.TransactionByHash(ctx, hash)
+}
+
+// TransactionCount returns the total number of transactions in the given block.
+func (c *L2Client) TransactionCount(ctx context.Context, blockHash common.Hash) (uint, error) {
+	return limitedCall(ctx, c.limiter, "eth_getBlockTransactionCountByHash", func() (uint, error) {
+		return c.client.TransactionCount(ctx, blockHash)
+	})
+}
+
+// TransactionInBlock returns a single transaction at index in the given block.
+func (c *L2Client) TransactionInBlock(
+	ctx context.Context,
+	blockHash common.Hash,
+	index uint,
+) (*types.Transaction, error) {
+	return limitedCall(ctx, c.limiter, "eth_getTransactionByBlockHashAndIndex", func() (*types.Transaction, error) {
+		return c.client.TransactionInBlock(ctx, blockHash, index)
+	})
+}
+
+// TransactionReceipt returns the receipt of a transaction by transaction hash.
+func (c *L2Client) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	return limitedCall(ctx, c.limiter, "eth_getTransactionReceipt", func() (*types.Receipt, error) {
+		return c.client.TransactionReceipt(ctx, txHash)
+	})
+}
+
+// SubscribeNewHead subscribes to notifications about the current blockchain head.
+func (c *L2Client) SubscribeNewHead(ctx context.Context, ch chan<- *types.Header) (ethereum.Subscription, error) {
+	ctx = WithRequestPriority(ctx, RequestPriorityHigh)
+	return limitedCall(ctx, c.limiter, "eth_subscribe", func() (ethereum.Subscription, error) {
+		return c.client.SubscribeNewHead(ctx, ch)
+	})
+}
+
+// SyncProgress retrieves the current progress of the sync algorithm.
+func (c *L2Client) SyncProgress(ctx context.Context) (*ethereum.SyncProgress, error) {
+	return limitedCall(ctx, c.limiter, "eth_syncing", func() (*ethereum.SyncProgress, error) {
+		return c.client.SyncProgress(ctx)
+	})
+}
+
+// PeerCount returns the number of p2p peers as reported by the net_peerCount method.
+func (c *L2Client) PeerCount(ctx context.Context) (uint64, error) {
+	return limitedCall(ctx, c.limiter, "net_peerCount", func() (uint64, error) {
+		return c.client.PeerCount(ctx)
+	})
+}
+
+// BalanceAt returns the wei balance of the given account.
+func (c *L2Client) BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error) {
+	return limitedCall(ctx, c.limiter, "eth_getBalance", func() (*big.Int, error) {
+		return c.client.BalanceAt(ctx, account, blockNumber)
+	})
+}
+
+// CodeAt returns the contract code of the given account.
+func (c *L2Client) CodeAt(ctx context.Context, account common.Address, blockNumber *big.Int) ([]byte, error) {
+	return limitedCall(ctx, c.limiter, "eth_getCode", func() ([]byte, error) {
+		return c.client.CodeAt(ctx, account, blockNumber)
+	})
+}
+
+// NonceAt returns the account nonce of the given account.
+func (c *L2Client) NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error) {
+	return limitedCall(ctx, c.limiter, "eth_getTransactionCount", func() (uint64, error) {
+		return c.client.NonceAt(ctx, account, blockNumber)
+	})
+}
+
+// PendingCodeAt returns the contract code of the given account in the pending state.
+func (c *L2Client) PendingCodeAt(ctx context.Context, account common.Address) ([]byte, error) {
+	return limitedCall(ctx, c.limiter, "eth_getCode", func() ([]byte, error) {
+		return c.client.PendingCodeAt(ctx, account)
+	})
+}
+
+// PendingNonceAt returns the account nonce of the given account in the pending state.
+func (c *L2Client) PendingNonceAt(ctx context.Context, account common.Address) (uint64, error) {
+	return limitedCall(ctx, c.limiter, "eth_getTransactionCount", func() (uint64, error) {
+		return c.client.PendingNonceAt(ctx, account)
+	})
+}
+
+// CallContract executes a message call transaction, which is directly executed in the VM
+// of the node, but never mined into the blockchain.
+func (c *L2Client) CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	return limitedCall(ctx, c.limiter, "eth_call", func() ([]byte, error) {
+		return c.client.CallContract(ctx, msg, blockNumber)
+	})
+}
+
+// SuggestGasPrice retrieves the currently suggested gas price to allow a timely
+// execution of a transaction.
+func (c *L2Client) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
+	return limitedCall(ctx, c.limiter, "eth_gasPrice", func() (*big.Int, error) {
+		return c.client.SuggestGasPrice(ctx)
+	})
+}
+
+// SuggestGasTipCap retrieves the currently suggested gas tip cap after 1559 to
+// allow a timely execution of a transaction.
+func (c *L2Client) SuggestGasTipCap(ctx context.Context) (*big.Int, error) {
+	return limitedCall(ctx, c.limiter, "eth_maxPriorityFeePerGas", func() (*big.Int, error) {
+		return c.client.SuggestGasTipCap(ctx)
+	})
+}
+
+// EstimateGas tries to estimate the gas needed to execute a specific transaction based on
+// the current pending state of the backend blockchain.
+func (c *L2Client) EstimateGas(ctx context.Context, msg ethereum.CallMsg) (uint64, error) {
+	return limitedCall(ctx, c.limiter, "eth_estimateGas", func() (uint64, error) {
+		return c.client.EstimateGas(ctx, msg)
+	})
+}
+
+// FilterLogs executes a filter query.
+func (c *L2Client) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+	return limitedCall(ctx, c.limiter, "eth_getLogs", func() ([]types.Log, error) {
+		return c.client.FilterLogs(ctx, q)
+	})
+}
+
+// SubscribeFilterLogs subscribes to the results of a streaming filter query.
+func (c *L2Client) SubscribeFilterLogs(
+	ctx context.Context,
+	q ethereum.FilterQuery,
+	ch chan<- types.Log,
+) (ethereum.Subscription, error) {
+	return limitedCall(ctx, c.limiter, "eth_subscribe", func() (ethereum.Subscription, error) {
+		return c.client.SubscribeFilterLogs(ctx, q, ch)
+	})
+}
+
+// SendTransaction injects a signed transaction into the pending pool for execution.
+func (c *L2Client) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	ctx = WithRequestPriority(ctx, RequestPriorityHigh)
+	if err := c.limiter.Wait(ctx, "eth_sendRawTransaction"); err != nil {
+		return err
+	}
+
+	return c.client.SendTransaction(ctx, tx)
+}
+
+// HeadL1Origin returns the latest L2 block's corresponding L1 origin.
+func (c *L2Client) HeadL1Origin(ctx context.Context) (*rawdb.L1Origin, error) {
+	return limitedCall(ctx, c.limiter, "taiko_headL1Origin", func() (*rawdb.L1Origin, error) {
+		return c.client.HeadL1Origin(ctx)
+	})
+}
+
+// L1OriginByID returns the L2 block's corresponding L1 origin.
+func (c *L2Client) L1OriginByID(ctx context.Context, blockID *big.Int) (*rawdb.L1Origin, error) {
+	return limitedCall(ctx, c.limiter, "taiko_l1OriginByID", func() (*rawdb.L1Origin, error) {
+		return c.client.L1OriginByID(ctx, blockID)
+	})
+}
+
+// GetThrowawayTransactionReceipts returns the receipts of the given throwaway block's transactions.
+func (c *L2Client) GetThrowawayTransactionReceipts(ctx context.Context, hash common.Hash) (types.Receipts, error) {
+	return limitedCall(ctx, c.limiter, "taiko_getThrowawayTransactionReceipts", func() (types.Receipts, error) {
+		return c.client.GetThrowawayTransactionReceipts(ctx, hash)
+	})
+}
+
+// SubscribePendingTransactions subscribes to notifications about the new pending transactions hashes.
+func (c *L2Client) SubscribePendingTransactions(
+	ctx context.Context,
+	ch chan<- common.Hash,
+) (ethereum.Subscription, error) {
+	return limitedCall(ctx, c.limiter, "eth_subscribe", func() (ethereum.Subscription, error) {
+		return c.rawClient.EthSubscribe(ctx, ch, "newPendingTransactions")
+	})
+}
+
+// CallContext performs a raw JSON-RPC call with the given arguments.
+func (c *L2Client) CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+	if err := c.limiter.Wait(ctx, method); err != nil {
+		return err
+	}
+
+	return c.rawClient.CallContext(ctx, result, method, args...)
+}
+
+// BatchCallContext sends all given requests as a single batch, each request is rate limited
+// individually.
+func (c *L2Client) BatchCallContext(ctx context.Context, b []rpc.BatchElem) error {
+	for _, elem := range b {
+		if err := c.limiter.Wait(ctx, elem.Method); err != nil {
+			return err
+		}
+	}
+
+	return c.rawClient.BatchCallContext(ctx, b)
+}
+
+// Close closes the underlying RPC connection.
+func (c *L2Client) Close() {
+	c.rawClient.Close()
+}
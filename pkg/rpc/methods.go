@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"math/big"
 	"sort"
-	"strings"
 	"time"
 
 	ethereum "github.com/ethereum/go-ethereum"
@@ -16,6 +15,7 @@ import (
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/taikoxyz/taiko-client/bindings"
+	rpcErrors "github.com/taikoxyz/taiko-client/pkg/rpc_errors"
 )
 
 // ensureGenesisMatched fetches the L2 genesis block from TaikoL1 contract,
@@ -66,12 +66,10 @@ func (c *Client) LatestL2KnownL1Header(ctx context.Context) (*types.Header, erro
 	headL1Origin, err := c.L2.HeadL1Origin(ctx)
 
 	if err != nil {
-		switch err.Error() {
-		case ethereum.NotFound.Error():
+		if rpcErrors.IsNotFound(err) {
 			return c.GetGenesisL1Header(ctx)
-		default:
-			return nil, err
 		}
+		return nil, err
 	}
 
 	if headL1Origin == nil {
@@ -80,18 +78,39 @@ func (c *Client) LatestL2KnownL1Header(ctx context.Context) (*types.Header, erro
 
 	header, err := c.L1.HeaderByHash(ctx, headL1Origin.L1BlockHash)
 	if err != nil {
-		switch err.Error() {
-		case ethereum.NotFound.Error():
+		if rpcErrors.IsNotFound(err) {
 			log.Warn("Latest L2 known L1 header not found, use genesis instead", "hash", headL1Origin.L1BlockHash)
 			return c.GetGenesisL1Header(ctx)
-		default:
-			return nil, err
 		}
+		return nil, err
 	}
 
 	return header, nil
 }
 
+// SubscribeL2NewHead subscribes to notifications about the current L2 head, the new heads will be
+// polled if the L2 endpoint is an HTTP endpoint.
+func (c *Client) SubscribeL2NewHead(ctx context.Context, ch chan<- *types.Header) (ethereum.Subscription, error) {
+	if c.l2Polling {
+		return SubscribeNewHeadByPolling(c.L2, DefaultPollingInterval, ch), nil
+	}
+
+	return c.L2.SubscribeNewHead(ctx, ch)
+}
+
+// SubscribeL2PendingTransactions subscribes to notifications about the new pending transactions in
+// the L2 execution engine's transaction pool, which will be polled if the L2 endpoint is an HTTP endpoint.
+func (c *Client) SubscribeL2PendingTransactions(
+	ctx context.Context,
+	ch chan<- common.Hash,
+) (ethereum.Subscription, error) {
+	if c.l2Polling {
+		return SubscribePendingTransactionsByPolling(c.L2RawRPC, DefaultPollingInterval, ch), nil
+	}
+
+	return c.L2.SubscribePendingTransactions(ctx, ch)
+}
+
 // GetGenesisL1Header fetches the L1 header that including L2 genesis block.
 func (c *Client) GetGenesisL1Header(ctx context.Context) (*types.Header, error) {
 	stateVars, err := c.GetProtocolStateVariables(nil)
@@ -219,7 +238,7 @@ func (c *Client) L2AccountNonce(
 func (c *Client) IsProverWhitelisted(prover common.Address) (bool, error) {
 	whitelisted, err := c.TaikoL1.IsProverWhitelisted(nil, prover)
 	if err != nil {
-		if strings.Contains(err.Error(), "reverted") {
+		if isWhitelistingDisabled(err) {
 			return true, nil
 		}
 
@@ -233,7 +252,7 @@ func (c *Client) IsProverWhitelisted(prover common.Address) (bool, error) {
 func (c *Client) IsProposerWhitelisted(proposer common.Address) (bool, error) {
 	whitelisted, err := c.TaikoL1.IsProposerWhitelisted(nil, proposer)
 	if err != nil {
-		if strings.Contains(err.Error(), "reverted") {
+		if isWhitelistingDisabled(err) {
 			return true, nil
 		}
 
@@ -243,6 +262,18 @@ func (c *Client) IsProposerWhitelisted(proposer common.Address) (bool, error) {
 	return whitelisted, nil
 }
 
+// isWhitelistingDisabled checks whether the given error is the revert of TaikoL1's whitelist queries
+// when whitelisting is disabled, in which case they fail the `assert` of the whitelisting switch.
+func isWhitelistingDisabled(err error) bool {
+	reverted, ok := rpcErrors.AsReverted(err)
+	if !ok {
+		return false
+	}
+
+	code, ok := reverted.PanicCode()
+	return ok && code == rpcErrors.PanicAssertionFailed
+}
+
 // GetProtocolConstants gets the protocol constants from TaikoL1 contract.
 func (c *Client) GetProtocolConstants(opts *bind.CallOpts) (*bindings.ProtocolConstants, error) {
 	return GetProtocolConstants(c.TaikoL1, opts)
@@ -2,11 +2,14 @@ package rpc
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/stretchr/testify/require"
 )
@@ -117,6 +120,28 @@ func TestIsProposerWhitelisted(t *testing.T) {
 	require.Nil(t, err)
 }
 
+// testRevertError is a JSON-RPC revert error with revert data.
+type testRevertError struct {
+	data string
+}
+
+func (e *testRevertError) Error() string          { return "execution reverted" }
+func (e *testRevertError) ErrorCode() int         { return 3 }
+func (e *testRevertError) ErrorData() interface{} { return e.data }
+
+func TestIsWhitelistingDisabled(t *testing.T) {
+	panicData := func(code byte) string {
+		return hexutil.Encode(
+			append(crypto.Keccak256([]byte("Panic(uint256)"))[:4], common.LeftPadBytes([]byte{code}, 32)...),
+		)
+	}
+
+	require.True(t, isWhitelistingDisabled(&testRevertError{data: panicData(0x01)}))
+	require.False(t, isWhitelistingDisabled(&testRevertError{data: panicData(0x11)}))
+	require.False(t, isWhitelistingDisabled(errors.New("execution reverted: L1:proposer")))
+	require.False(t, isWhitelistingDisabled(errors.New("connection refused")))
+}
+
 func TestGetProtocolConstants(t *testing.T) {
 	client := newTestClient(t)
 	_, err := client.GetProtocolConstants(nil)
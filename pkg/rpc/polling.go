@@ -0,0 +1,274 @@
+package rpc
+
+import (
+	"context"
+	"math/big"
+	"net/url"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+const (
+	// Default interval between two polls of a polling subscription.
+	DefaultPollingInterval = 3 * time.Second
+	// Number of the most recent blocks whose logs will be fetched again in each poll, to find out
+	// the logs removed by L1 reorgs.
+	pollingReorgWindow = 64
+	// Timeout of a single poll.
+	pollingTimeout = 10 * time.Second
+)
+
+// IsHTTPEndpoint checks whether the given RPC endpoint uses the HTTP(S) transport, which doesn't
+// support subscriptions.
+func IsHTTPEndpoint(endpoint string) bool {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return false
+	}
+
+	return u.Scheme == "http" || u.Scheme == "https"
+}
+
+// headPollingBackend is the backend of SubscribeNewHeadByPolling.
+type headPollingBackend interface {
+	BlockNumber(ctx context.Context) (uint64, error)
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+}
+
+// logsPollingBackend is the backend of SubscribeFilterLogsByPolling.
+type logsPollingBackend interface {
+	BlockNumber(ctx context.Context) (uint64, error)
+	FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error)
+}
+
+// pendingTxsPollingBackend is the backend of SubscribePendingTransactionsByPolling.
+type pendingTxsPollingBackend interface {
+	CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error
+}
+
+// SubscribeNewHeadByPolling subscribes to notifications about the current blockchain head by polling
+// `eth_blockNumber`, a new head will be sent whenever the head number changes.
+func SubscribeNewHeadByPolling(
+	client headPollingBackend,
+	interval time.Duration,
+	ch chan<- *types.Header,
+) ethereum.Subscription {
+	var lastHead *types.Header
+
+	return newPollingSubscription(interval, func(ctx context.Context, quit <-chan struct{}) error {
+		headNumber, err := client.BlockNumber(ctx)
+		if err != nil {
+			return err
+		}
+
+		if lastHead != nil && lastHead.Number.Uint64() == headNumber {
+			return nil
+		}
+
+		head, err := client.HeaderByNumber(ctx, new(big.Int).SetUint64(headNumber))
+		if err != nil {
+			return err
+		}
+
+		select {
+		case ch <- head:
+			lastHead = head
+		case <-quit:
+		}
+
+		return nil
+	})
+}
+
+// SubscribeFilterLogsByPolling subscribes to the results of a streaming filter query by polling
+// `eth_getLogs`. Like the websocket subscriptions, logs removed by reorgs will be sent again with
+// the `Removed` field set to true.
+func SubscribeFilterLogsByPolling(
+	client logsPollingBackend,
+	q ethereum.FilterQuery,
+	interval time.Duration,
+	ch chan<- types.Log,
+) ethereum.Subscription {
+	poller := &logsPoller{client: client, query: q, ch: ch}
+
+	return newPollingSubscription(interval, poller.poll)
+}
+
+// SubscribePendingTransactionsByPolling subscribes to notifications about the new pending transactions
+// hashes by polling a pending transactions filter, which will be re-installed if it has expired.
+func SubscribePendingTransactionsByPolling(
+	client pendingTxsPollingBackend,
+	interval time.Duration,
+	ch chan<- common.Hash,
+) ethereum.Subscription {
+	var filterID string
+
+	return newPollingSubscription(interval, func(ctx context.Context, quit <-chan struct{}) error {
+		if len(filterID) == 0 {
+			if err := client.CallContext(ctx, &filterID, "eth_newPendingTransactionFilter"); err != nil {
+				return err
+			}
+		}
+
+		var hashes []common.Hash
+		if err := client.CallContext(ctx, &hashes, "eth_getFilterChanges", filterID); err != nil {
+			filterID = ""
+			return err
+		}
+
+		for _, hash := range hashes {
+			select {
+			case ch <- hash:
+			case <-quit:
+				return nil
+			}
+		}
+
+		return nil
+	})
+}
+
+// newPollingSubscription creates a subscription which calls the given poll function at the given
+// interval, until it is unsubscribed or the poll function fails because of the endpoint.
+func newPollingSubscription(
+	interval time.Duration,
+	poll func(ctx context.Context, quit <-chan struct{}) error,
+) ethereum.Subscription {
+	if interval == 0 {
+		interval = DefaultPollingInterval
+	}
+
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			// Polling subscriptions track the chain head, which should not be starved by the
+			// backfill requests.
+			ctx, cancel := context.WithTimeout(
+				WithRequestPriority(context.Background(), RequestPriorityHigh),
+				pollingTimeout,
+			)
+			err := poll(ctx, quit)
+			cancel()
+
+			if err != nil {
+				if isEndpointError(err) {
+					return err
+				}
+				log.Warn("Polling subscription request failed, retry in next poll", "error", err)
+			}
+
+			select {
+			case <-quit:
+				return nil
+			case <-ticker.C:
+			}
+		}
+	})
+}
+
+// logKey identifies a log in a block.
+type logKey struct {
+	blockHash common.Hash
+	index     uint
+}
+
+// logsPoller keeps track of the logs sent in the reorg window, to find out the logs removed by reorgs.
+type logsPoller struct {
+	client logsPollingBackend
+	query  ethereum.FilterQuery
+	ch     chan<- types.Log
+
+	started bool
+	start   uint64 // lowest block to fetch logs from
+	next    uint64 // next block to fetch logs from
+	sent    []types.Log
+}
+
+// poll fetches the logs in the reorg window and the new blocks, then sends the removed logs in
+// reverse order, and the new logs in order.
+func (p *logsPoller) poll(ctx context.Context, quit <-chan struct{}) error {
+	head, err := p.client.BlockNumber(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !p.started {
+		// Same as the websocket subscriptions, only the logs in the new blocks will be sent
+		// if no start block is given.
+		if p.query.FromBlock != nil {
+			p.start = p.query.FromBlock.Uint64()
+		} else {
+			p.start = head + 1
+		}
+		p.next, p.started = p.start, true
+	}
+
+	from := p.start
+	if p.next > from+pollingReorgWindow {
+		from = p.next - pollingReorgWindow
+	}
+
+	var logs []types.Log
+	if from <= head {
+		q := p.query
+		q.FromBlock, q.ToBlock = new(big.Int).SetUint64(from), new(big.Int).SetUint64(head)
+
+		if logs, err = p.client.FilterLogs(ctx, q); err != nil {
+			return err
+		}
+	}
+
+	removed, added := diffLogs(p.sent, logs, from)
+	for _, l := range append(removed, added...) {
+		select {
+		case p.ch <- l:
+		case <-quit:
+			return nil
+		}
+	}
+
+	p.sent, p.next = logs, head+1
+
+	return nil
+}
+
+// diffLogs compares the previously sent logs with the newly fetched logs since the given block,
+// returns the removed logs in reverse order, and the added logs in order.
+func diffLogs(sent []types.Log, fetched []types.Log, from uint64) (removed []types.Log, added []types.Log) {
+	sentKeys := make(map[logKey]struct{}, len(sent))
+	for _, l := range sent {
+		sentKeys[logKey{l.BlockHash, l.Index}] = struct{}{}
+	}
+
+	fetchedKeys := make(map[logKey]struct{}, len(fetched))
+	for _, l := range fetched {
+		key := logKey{l.BlockHash, l.Index}
+		fetchedKeys[key] = struct{}{}
+
+		if _, ok := sentKeys[key]; !ok {
+			added = append(added, l)
+		}
+	}
+
+	for i := len(sent) - 1; i >= 0; i-- {
+		l := sent[i]
+		// The logs before the reorg window are considered final.
+		if l.BlockNumber < from {
+			continue
+		}
+
+		if _, ok := fetchedKeys[logKey{l.BlockHash, l.Index}]; !ok {
+			l.Removed = true
+			removed = append(removed, l)
+		}
+	}
+
+	return removed, added
+}
@@ -0,0 +1,185 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+)
+
+// testPollingBackend is an in-memory chain for testing polling subscriptions.
+type testPollingBackend struct {
+	head uint64
+	logs []types.Log
+	mu   sync.Mutex
+}
+
+func (b *testPollingBackend) setChain(head uint64, logs []types.Log) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.head, b.logs = head, logs
+}
+
+func (b *testPollingBackend) BlockNumber(ctx context.Context) (uint64, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.head, nil
+}
+
+func (b *testPollingBackend) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	return &types.Header{Number: number, Difficulty: common.Big0}, nil
+}
+
+func (b *testPollingBackend) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	var logs []types.Log
+	for _, l := range b.logs {
+		if l.BlockNumber >= q.FromBlock.Uint64() && l.BlockNumber <= q.ToBlock.Uint64() {
+			logs = append(logs, l)
+		}
+	}
+
+	return logs, nil
+}
+
+// testLogsCount makes the block hashes of the test logs different.
+var testLogsCount int64
+
+func newTestLog(blockNumber uint64, index uint) types.Log {
+	testLogsCount++
+	return types.Log{BlockNumber: blockNumber, BlockHash: common.BigToHash(big.NewInt(testLogsCount)), Index: index}
+}
+
+func receiveLog(t *testing.T, ch <-chan types.Log) types.Log {
+	select {
+	case l := <-ch:
+		return l
+	case <-time.After(5 * time.Second):
+		t.Fatal("log not received")
+	}
+	return types.Log{}
+}
+
+func TestIsHTTPEndpoint(t *testing.T) {
+	require.True(t, IsHTTPEndpoint("http://localhost:8545"))
+	require.True(t, IsHTTPEndpoint("https://localhost:8545"))
+	require.False(t, IsHTTPEndpoint("ws://localhost:8546"))
+	require.False(t, IsHTTPEndpoint("/tmp/geth.ipc"))
+}
+
+func TestSubscribeNewHeadByPolling(t *testing.T) {
+	backend := &testPollingBackend{head: 1}
+	ch := make(chan *types.Header, 10)
+
+	sub := SubscribeNewHeadByPolling(backend, 10*time.Millisecond, ch)
+	defer sub.Unsubscribe()
+
+	require.Equal(t, uint64(1), (<-ch).Number.Uint64())
+
+	backend.setChain(3, nil)
+	require.Equal(t, uint64(3), (<-ch).Number.Uint64())
+}
+
+func TestSubscribeFilterLogsByPolling(t *testing.T) {
+	oldLog := newTestLog(1, 0)
+	reorgedLog := newTestLog(2, 0)
+
+	backend := &testPollingBackend{head: 2, logs: []types.Log{oldLog, reorgedLog}}
+	ch := make(chan types.Log, 10)
+
+	sub := SubscribeFilterLogsByPolling(
+		backend,
+		ethereum.FilterQuery{FromBlock: common.Big1},
+		10*time.Millisecond,
+		ch,
+	)
+	defer sub.Unsubscribe()
+
+	require.Equal(t, oldLog, receiveLog(t, ch))
+	require.Equal(t, reorgedLog, receiveLog(t, ch))
+
+	// Block 2 is reorged out.
+	newLog := newTestLog(2, 0)
+	backend.setChain(3, []types.Log{oldLog, newLog})
+
+	removed := receiveLog(t, ch)
+	require.True(t, removed.Removed)
+	require.Equal(t, reorgedLog.BlockHash, removed.BlockHash)
+	require.Equal(t, newLog, receiveLog(t, ch))
+}
+
+// testFilterService is an eth namespace JSON-RPC service, whose pending transactions filters expire
+// after being polled once.
+type testFilterService struct {
+	filters int
+	polled  map[string]bool
+	mu      sync.Mutex
+}
+
+func (s *testFilterService) NewPendingTransactionFilter() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.filters++
+	return fmt.Sprintf("0x%d", s.filters)
+}
+
+func (s *testFilterService) GetFilterChanges(id string) ([]common.Hash, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.polled[id] {
+		return nil, errors.New("filter not found")
+	}
+	s.polled[id] = true
+
+	return []common.Hash{common.HexToHash(id)}, nil
+}
+
+func TestSubscribePendingTransactionsByPolling(t *testing.T) {
+	server := rpc.NewServer()
+	defer server.Stop()
+	require.Nil(t, server.RegisterName("eth", &testFilterService{polled: make(map[string]bool)}))
+
+	ch := make(chan common.Hash, 10)
+	sub := SubscribePendingTransactionsByPolling(rpc.DialInProc(server), 10*time.Millisecond, ch)
+	defer sub.Unsubscribe()
+
+	// A new filter should be installed after the previous one expires.
+	require.Equal(t, common.HexToHash("0x1"), <-ch)
+	require.Equal(t, common.HexToHash("0x2"), <-ch)
+}
+
+func TestDiffLogs(t *testing.T) {
+	finalized := newTestLog(1, 0)
+	kept := newTestLog(10, 0)
+	reorged := []types.Log{newTestLog(11, 0), newTestLog(11, 1)}
+	added := newTestLog(11, 0)
+
+	removedLogs, addedLogs := diffLogs(
+		append([]types.Log{finalized, kept}, reorged...),
+		[]types.Log{kept, added},
+		10,
+	)
+
+	require.Len(t, removedLogs, 2)
+	require.Equal(t, reorged[1].Index, removedLogs[0].Index)
+	require.Equal(t, reorged[0].Index, removedLogs[1].Index)
+	for _, l := range removedLogs {
+		require.True(t, l.Removed)
+	}
+	require.Equal(t, []types.Log{added}, addedLogs)
+}
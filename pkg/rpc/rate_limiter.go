@@ -0,0 +1,165 @@
+package rpc
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/metrics"
+)
+
+// RequestPriority is the priority lane of an RPC request, when throttled, requests in a higher
+// priority lane take the tokens first.
+type RequestPriority int
+
+// All request priorities, in ascending order.
+const (
+	// Default priority, e.g. the backfill requests of catch-up syncing.
+	RequestPriorityLow RequestPriority = iota
+	// Priority of the requests which must not be starved, e.g. the head tracking requests.
+	RequestPriorityHigh
+	numRequestPriorities
+)
+
+// requestPriorityKey is the context key of the request priority.
+type requestPriorityKey struct{}
+
+// WithRequestPriority returns a copy of the given context, the RPC requests with which will be
+// sent in the given priority lane.
+func WithRequestPriority(ctx context.Context, priority RequestPriority) context.Context {
+	return context.WithValue(ctx, requestPriorityKey{}, priority)
+}
+
+// requestPriority returns the request priority of the given context.
+func requestPriority(ctx context.Context) RequestPriority {
+	if priority, ok := ctx.Value(requestPriorityKey{}).(RequestPriority); ok {
+		return priority
+	}
+
+	return RequestPriorityLow
+}
+
+// RateLimiter limits the rate of the RPC requests sent to a node, with separate token buckets
+// for the log queries and the other requests. A nil RateLimiter doesn't limit any request.
+type RateLimiter struct {
+	calls            *tokenBucket
+	logs             *tokenBucket
+	throttledCounter metrics.Counter
+}
+
+// NewRateLimiter creates a new rate limiter, which allows callsPerSecond requests and logsPerSecond
+// log queries per second, zero means unlimited. Throttled requests are counted by the given counter.
+func NewRateLimiter(callsPerSecond uint64, logsPerSecond uint64, throttledCounter metrics.Counter) *RateLimiter {
+	if callsPerSecond == 0 && logsPerSecond == 0 {
+		return nil
+	}
+
+	return &RateLimiter{
+		calls:            newTokenBucket(callsPerSecond),
+		logs:             newTokenBucket(logsPerSecond),
+		throttledCounter: throttledCounter,
+	}
+}
+
+// Wait blocks until the request of the given method is allowed to be sent, or the given context is done.
+func (l *RateLimiter) Wait(ctx context.Context, method string) error {
+	if l == nil {
+		return nil
+	}
+
+	bucket := l.calls
+	if method == "eth_getLogs" {
+		bucket = l.logs
+	}
+
+	priority := requestPriority(ctx)
+	throttled, err := bucket.wait(ctx, priority)
+	if throttled {
+		log.Debug("RPC request throttled", "method", method, "priority", priority)
+		l.throttledCounter.Inc(1)
+	}
+
+	return err
+}
+
+// tokenBucket is a token bucket with priority lanes, a waiter can only take a token when there is
+// no waiter in the higher priority lanes. A nil tokenBucket is unlimited.
+type tokenBucket struct {
+	rate    float64 // tokens per second, also the bucket capacity
+	tokens  float64
+	last    time.Time
+	waiting [numRequestPriorities]int
+	mutex   sync.Mutex
+}
+
+// newTokenBucket creates a new full token bucket, or returns nil if the given rate is zero.
+func newTokenBucket(rate uint64) *tokenBucket {
+	if rate == 0 {
+		return nil
+	}
+
+	return &tokenBucket{rate: float64(rate), tokens: float64(rate), last: time.Now()}
+}
+
+// wait blocks until a token is taken in the given priority lane, or the given context is done, and
+// returns whether it has been throttled.
+func (b *tokenBucket) wait(ctx context.Context, priority RequestPriority) (throttled bool, err error) {
+	if b == nil {
+		return false, nil
+	}
+
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	b.waiting[priority]++
+	defer func() { b.waiting[priority]-- }()
+
+	for {
+		b.refill(time.Now())
+		if b.tokens >= 1 && !b.hasHigherPriorityWaiter(priority) {
+			b.tokens--
+			return throttled, nil
+		}
+
+		// Wait for the next token, or for the higher priority waiters to take the available tokens.
+		delay := time.Duration(float64(time.Second) / b.rate)
+		if b.tokens < 1 {
+			delay = time.Duration((1 - b.tokens) * float64(time.Second) / b.rate)
+		}
+
+		throttled = true
+		b.mutex.Unlock()
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			b.mutex.Lock()
+			return throttled, ctx.Err()
+		case <-timer.C:
+		}
+
+		b.mutex.Lock()
+	}
+}
+
+// refill adds the tokens generated since the last refill.
+func (b *tokenBucket) refill(now time.Time) {
+	b.tokens += now.Sub(b.last).Seconds() * b.rate
+	if b.tokens > b.rate {
+		b.tokens = b.rate
+	}
+	b.last = now
+}
+
+// hasHigherPriorityWaiter checks whether there is any waiter in a higher priority lane.
+func (b *tokenBucket) hasHigherPriorityWaiter(priority RequestPriority) bool {
+	for p := priority + 1; p < numRequestPriorities; p++ {
+		if b.waiting[p] > 0 {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,92 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/metrics"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequestPriority(t *testing.T) {
+	require.Equal(t, RequestPriorityLow, requestPriority(context.Background()))
+	require.Equal(
+		t,
+		RequestPriorityHigh,
+		requestPriority(WithRequestPriority(context.Background(), RequestPriorityHigh)),
+	)
+}
+
+func TestRateLimiterUnlimited(t *testing.T) {
+	require.Nil(t, NewRateLimiter(0, 0, metrics.NilCounter{}))
+
+	var limiter *RateLimiter
+	require.Nil(t, limiter.Wait(context.Background(), "eth_getLogs"))
+}
+
+func TestRateLimiterThrottle(t *testing.T) {
+	counter := metrics.NewCounterForced()
+	limiter := NewRateLimiter(10, 1, counter)
+
+	// The log queries have a separate budget.
+	require.Nil(t, limiter.Wait(context.Background(), "eth_getLogs"))
+	for i := 0; i < 10; i++ {
+		require.Nil(t, limiter.Wait(context.Background(), "eth_blockNumber"))
+	}
+	require.Zero(t, counter.Count())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	require.ErrorIs(t, limiter.Wait(ctx, "eth_getLogs"), context.DeadlineExceeded)
+	require.Equal(t, int64(1), counter.Count())
+
+	require.Nil(t, limiter.Wait(context.Background(), "eth_blockNumber"))
+	require.Equal(t, int64(2), counter.Count())
+}
+
+func TestTokenBucketPriority(t *testing.T) {
+	bucket := newTokenBucket(10)
+	for i := 0; i < 10; i++ {
+		throttled, err := bucket.wait(context.Background(), RequestPriorityLow)
+		require.Nil(t, err)
+		require.False(t, throttled)
+	}
+
+	done := make(chan RequestPriority, 2)
+	wait := func(priority RequestPriority) {
+		if throttled, err := bucket.wait(context.Background(), priority); err == nil && throttled {
+			done <- priority
+		}
+	}
+
+	go wait(RequestPriorityLow)
+	time.Sleep(10 * time.Millisecond)
+	go wait(RequestPriorityHigh)
+
+	// The high priority waiter should take the next token first.
+	require.Equal(t, RequestPriorityHigh, <-done)
+	require.Equal(t, RequestPriorityLow, <-done)
+}
+
+func TestL2ClientRateLimited(t *testing.T) {
+	server := rpc.NewServer()
+	require.Nil(t, server.RegisterName("eth", &testEthService{head: 100}))
+	defer server.Stop()
+
+	counter := metrics.NewCounterForced()
+	client := NewL2Client(rpc.DialInProc(server), NewRateLimiter(1, 1, counter))
+
+	chainID, err := client.ChainID(context.Background())
+	require.Nil(t, err)
+	require.Equal(t, int64(1), chainID.Int64())
+
+	// The raw calls share the same budget with the other requests.
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	var head hexutil.Uint64
+	require.ErrorIs(t, client.CallContext(ctx, &head, "eth_blockNumber"), context.DeadlineExceeded)
+	require.Equal(t, int64(1), counter.Count())
+}
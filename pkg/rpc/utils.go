@@ -6,10 +6,10 @@ import (
 	"math/big"
 	"time"
 
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/ethclient"
-	"github.com/ethereum/go-ethereum/ethclient/gethclient"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/taikoxyz/taiko-client/bindings"
@@ -62,7 +62,7 @@ func GetProtocolStateVariables(
 
 // WaitConfirmations won't return before N blocks confirmations have been seen
 // on destination chain.
-func WaitConfirmations(ctx context.Context, client *ethclient.Client, confirmations uint64, begin uint64) error {
+func WaitConfirmations(ctx context.Context, client *FailoverClient, confirmations uint64, begin uint64) error {
 	ticker := time.NewTicker(time.Second)
 	defer ticker.Stop()
 
@@ -88,7 +88,11 @@ func WaitConfirmations(ctx context.Context, client *ethclient.Client, confirmati
 
 // WaitReceipt keeps waiting until the given transaction has an execution
 // receipt to know whether it was reverted or not.
-func WaitReceipt(ctx context.Context, client *ethclient.Client, tx *types.Transaction) (*types.Receipt, error) {
+func WaitReceipt(
+	ctx context.Context,
+	client ethereum.TransactionReader,
+	tx *types.Transaction,
+) (*types.Receipt, error) {
 	ticker := time.NewTicker(time.Second)
 	defer ticker.Stop()
 
@@ -112,7 +116,7 @@ func WaitReceipt(ctx context.Context, client *ethclient.Client, tx *types.Transa
 }
 
 // GetReceiptsByBlock fetches all transaction receipts in a block.
-func GetReceiptsByBlock(ctx context.Context, cli *rpc.Client, block *types.Block) (types.Receipts, error) {
+func GetReceiptsByBlock(ctx context.Context, cli RawRPCClient, block *types.Block) (types.Receipts, error) {
 	reqs := make([]rpc.BatchElem, block.Transactions().Len())
 	receipts := make([]*types.Receipt, block.Transactions().Len())
 
@@ -143,6 +147,6 @@ func GetReceiptsByBlock(ctx context.Context, cli *rpc.Client, block *types.Block
 
 // SetHead makes a `debug_setHead` RPC call to set the chain's head, should only be used
 // for testing purpose.
-func SetHead(ctx context.Context, rpc *rpc.Client, headNum *big.Int) error {
-	return gethclient.New(rpc).SetHead(ctx, headNum)
+func SetHead(ctx context.Context, client RawRPCClient, headNum *big.Int) error {
+	return client.CallContext(ctx, nil, "debug_setHead", hexutil.EncodeBig(headNum))
 }
@@ -0,0 +1,242 @@
+package rpcerrors
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// JSON-RPC error codes used for classification.
+const (
+	codeMethodNotFound    = -32601
+	codeExecutionReverted = 3
+)
+
+// Prefixes of the protocol contracts' revert reasons, e.g. `L1:tooLate`.
+var protocolRevertReasonPrefixes = []string{"L1:", "L2:"}
+
+// PanicAssertionFailed is the panic code of a failed `assert`.
+const PanicAssertionFailed uint64 = 0x01
+
+// panicSelector is the selector of the `Panic(uint256)` revert data.
+var panicSelector = crypto.Keccak256([]byte("Panic(uint256)"))[:4]
+
+// Typed errors which the JSON-RPC errors are decoded into.
+var (
+	ErrMethodNotFound    = errors.New("method not found")
+	ErrNotFound          = errors.New("not found")
+	ErrNonceTooLow       = errors.New("nonce too low")
+	ErrNonceTooHigh      = errors.New("nonce too high")
+	ErrAlreadyKnown      = errors.New("transaction already known")
+	ErrUnderpriced       = errors.New("transaction underpriced")
+	ErrInsufficientFunds = errors.New("insufficient funds")
+)
+
+// knownMessages maps the error messages of the transaction pool, which are all returned with
+// the same generic error code, to the typed errors.
+var knownMessages = []struct {
+	message string
+	err     error
+}{
+	{"nonce too low", ErrNonceTooLow},
+	{"nonce too high", ErrNonceTooHigh},
+	{"already known", ErrAlreadyKnown},
+	{"underpriced", ErrUnderpriced},
+	{"insufficient funds", ErrInsufficientFunds},
+}
+
+// ErrReverted is the typed error of a reverted call or transaction, with its decoded revert reason.
+type ErrReverted struct {
+	Reason string
+	Data   []byte
+}
+
+// Error implements the error interface.
+func (e *ErrReverted) Error() string {
+	if e.Reason == "" {
+		return "execution reverted"
+	}
+
+	return "execution reverted: " + e.Reason
+}
+
+// IsProtocolRevert checks whether the revert reason is defined by the protocol contracts.
+func (e *ErrReverted) IsProtocolRevert() bool {
+	for _, prefix := range protocolRevertReasonPrefixes {
+		if strings.HasPrefix(e.Reason, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// PanicCode returns the panic code if the revert data is a `Panic(uint256)` error, e.g. a failed `assert`.
+func (e *ErrReverted) PanicCode() (uint64, bool) {
+	if len(e.Data) != len(panicSelector)+32 || !bytes.Equal(e.Data[:len(panicSelector)], panicSelector) {
+		return 0, false
+	}
+
+	code := new(big.Int).SetBytes(e.Data[len(panicSelector):])
+	if !code.IsUint64() {
+		return 0, false
+	}
+
+	return code.Uint64(), true
+}
+
+// decodedError wraps the original error with its typed error, so that both of them can be
+// matched by errors.Is and errors.As.
+type decodedError struct {
+	typed error
+	err   error
+}
+
+// Error implements the error interface.
+func (e *decodedError) Error() string {
+	return e.err.Error()
+}
+
+// Unwrap returns the original error.
+func (e *decodedError) Unwrap() error {
+	return e.err
+}
+
+// Is matches the typed error.
+func (e *decodedError) Is(target error) bool {
+	return errors.Is(e.typed, target)
+}
+
+// As matches the typed error.
+func (e *decodedError) As(target interface{}) bool {
+	return errors.As(e.typed, target)
+}
+
+// Decode decodes the given error by its JSON-RPC error code, revert data and message, returns an
+// error which matches both the typed error and the given error. If the given error can not be
+// decoded, returns it as is.
+func Decode(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var decoded *decodedError
+	if errors.As(err, &decoded) {
+		return err
+	}
+
+	if typed := decode(err); typed != nil {
+		return &decodedError{typed: typed, err: err}
+	}
+
+	return err
+}
+
+// decode returns the typed error of the given error, or nil if unknown.
+func decode(err error) error {
+	// The ethclient returns ethereum.NotFound for the null results, while the L2 execution engine's
+	// taiko namespace returns a JSON-RPC error with the same message.
+	if errors.Is(err, ethereum.NotFound) {
+		return ErrNotFound
+	}
+
+	var rpcErr rpc.Error
+	if errors.As(err, &rpcErr) {
+		switch rpcErr.ErrorCode() {
+		case codeMethodNotFound:
+			return ErrMethodNotFound
+		case codeExecutionReverted:
+			return decodeReverted(err)
+		}
+	}
+
+	// Some nodes don't return the specific error codes, fall back to the error messages.
+	message := strings.ToLower(err.Error())
+	if strings.Contains(message, "execution reverted") {
+		return decodeReverted(err)
+	}
+
+	if strings.Contains(message, "method") &&
+		(strings.Contains(message, "not found") || strings.Contains(message, "does not exist")) {
+		return ErrMethodNotFound
+	}
+
+	if message == ethereum.NotFound.Error() {
+		return ErrNotFound
+	}
+
+	for _, known := range knownMessages {
+		if strings.Contains(message, known.message) {
+			return known.err
+		}
+	}
+
+	return nil
+}
+
+// decodeReverted decodes the revert reason from the revert data of the given error, or from
+// its message if there is no revert data.
+func decodeReverted(err error) *ErrReverted {
+	reverted := new(ErrReverted)
+
+	var dataErr rpc.DataError
+	if errors.As(err, &dataErr) {
+		if hexData, ok := dataErr.ErrorData().(string); ok {
+			if data, err := hexutil.Decode(hexData); err == nil {
+				reverted.Data = data
+				if reason, err := abi.UnpackRevert(data); err == nil {
+					reverted.Reason = reason
+					return reverted
+				}
+			}
+		}
+	}
+
+	if _, reason, found := strings.Cut(err.Error(), "execution reverted: "); found {
+		reverted.Reason = reason
+	}
+
+	return reverted
+}
+
+// AsReverted returns the typed revert error of the given error, if it is a revert.
+func AsReverted(err error) (*ErrReverted, bool) {
+	var reverted *ErrReverted
+	if errors.As(Decode(err), &reverted) {
+		return reverted, true
+	}
+
+	return nil, false
+}
+
+// IsNotFound checks whether the given error means that the requested data is not found.
+func IsNotFound(err error) bool {
+	return errors.Is(Decode(err), ErrNotFound)
+}
+
+// IsRetryable checks whether a request which failed with the given error might succeed if retried.
+// Errors caused by the request itself, e.g. the protocol reverts, can not be fixed by retrying.
+func IsRetryable(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) {
+		return false
+	}
+
+	err = Decode(err)
+	if errors.Is(err, ErrMethodNotFound) {
+		return false
+	}
+
+	if reverted, ok := AsReverted(err); ok {
+		return !reverted.IsProtocolRevert()
+	}
+
+	return true
+}
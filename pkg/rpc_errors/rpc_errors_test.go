@@ -0,0 +1,112 @@
+package rpcerrors
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+// testRPCError is a JSON-RPC error with code and data, same as the ones returned by ethclient.
+type testRPCError struct {
+	code    int
+	message string
+	data    interface{}
+}
+
+func (e *testRPCError) Error() string          { return e.message }
+func (e *testRPCError) ErrorCode() int         { return e.code }
+func (e *testRPCError) ErrorData() interface{} { return e.data }
+
+// packRevert packs the given reason as the revert data of `Error(string)`.
+func packRevert(t *testing.T, reason string) string {
+	stringType, err := abi.NewType("string", "", nil)
+	require.Nil(t, err)
+
+	packed, err := abi.Arguments{{Type: stringType}}.Pack(reason)
+	require.Nil(t, err)
+
+	return hexutil.Encode(append(crypto.Keccak256([]byte("Error(string)"))[:4], packed...))
+}
+
+func TestDecode(t *testing.T) {
+	require.Nil(t, Decode(nil))
+
+	unknown := errors.New("test")
+	require.Equal(t, unknown, Decode(unknown))
+
+	methodNotFound := &testRPCError{code: -32601, message: "the method eth_maxPriorityFeePerGas does not exist"}
+	err := Decode(fmt.Errorf("test: %w", methodNotFound))
+	require.ErrorIs(t, err, ErrMethodNotFound)
+	require.ErrorAs(t, err, new(*testRPCError))
+	require.Equal(t, err, Decode(err))
+
+	require.ErrorIs(t, Decode(errors.New("Method eth_maxPriorityFeePerGas not found")), ErrMethodNotFound)
+	require.ErrorIs(t, Decode(&testRPCError{code: -32000, message: "nonce too low"}), ErrNonceTooLow)
+	require.ErrorIs(t, Decode(errors.New("replacement transaction underpriced")), ErrUnderpriced)
+	require.ErrorIs(t, Decode(errors.New("already known")), ErrAlreadyKnown)
+	require.ErrorIs(t, Decode(errors.New("insufficient funds for gas * price + value")), ErrInsufficientFunds)
+}
+
+func TestIsNotFound(t *testing.T) {
+	require.False(t, IsNotFound(nil))
+	require.True(t, IsNotFound(ethereum.NotFound))
+	require.True(t, IsNotFound(fmt.Errorf("test: %w", ethereum.NotFound)))
+	require.True(t, IsNotFound(&testRPCError{code: -32000, message: "not found"}))
+	require.False(t, IsNotFound(&testRPCError{code: -32601, message: "the method taiko_headL1Origin does not exist"}))
+	require.False(t, IsNotFound(errors.New("header not found in the cache")))
+}
+
+func TestAsReverted(t *testing.T) {
+	_, ok := AsReverted(errors.New("test"))
+	require.False(t, ok)
+
+	// Revert reason decoded from the revert data.
+	reverted, ok := AsReverted(&testRPCError{code: 3, message: "execution reverted", data: packRevert(t, "L1:tooLate")})
+	require.True(t, ok)
+	require.Equal(t, "L1:tooLate", reverted.Reason)
+	require.NotEmpty(t, reverted.Data)
+	require.True(t, reverted.IsProtocolRevert())
+
+	// Revert reason decoded from the error message.
+	reverted, ok = AsReverted(errors.New("execution reverted: L2:baseFee"))
+	require.True(t, ok)
+	require.Equal(t, "L2:baseFee", reverted.Reason)
+	require.True(t, reverted.IsProtocolRevert())
+
+	reverted, ok = AsReverted(errors.New("execution reverted"))
+	require.True(t, ok)
+	require.Empty(t, reverted.Reason)
+	require.False(t, reverted.IsProtocolRevert())
+}
+
+func TestPanicCode(t *testing.T) {
+	panicData := append(crypto.Keccak256([]byte("Panic(uint256)"))[:4], common.LeftPadBytes([]byte{0x01}, 32)...)
+	reverted, ok := AsReverted(&testRPCError{code: 3, message: "execution reverted", data: hexutil.Encode(panicData)})
+	require.True(t, ok)
+	require.Empty(t, reverted.Reason)
+
+	code, ok := reverted.PanicCode()
+	require.True(t, ok)
+	require.Equal(t, PanicAssertionFailed, code)
+
+	_, ok = (&ErrReverted{Reason: "L1:tooLate"}).PanicCode()
+	require.False(t, ok)
+}
+
+func TestIsRetryable(t *testing.T) {
+	require.False(t, IsRetryable(nil))
+	require.False(t, IsRetryable(context.Canceled))
+	require.False(t, IsRetryable(&testRPCError{code: -32601, message: "method not found"}))
+	require.False(t, IsRetryable(errors.New("execution reverted: L1:tooLate")))
+	require.True(t, IsRetryable(errors.New("execution reverted")))
+	require.True(t, IsRetryable(errors.New("nonce too low")))
+	require.True(t, IsRetryable(errors.New("connection refused")))
+}
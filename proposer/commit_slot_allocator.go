@@ -0,0 +1,203 @@
+package proposer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	fileUtil "github.com/taikoxyz/taiko-client/pkg/file_util"
+)
+
+// maxCommitSlotsChecked is the maximum number of slots checked by a single allocation, starting
+// from the base slot.
+const maxCommitSlotsChecked = 1024
+
+// commitSlotRecord represents an in-use commit slot, the commit which is being stored in it, the
+// L1 height when the slot was allocated, and the height of the commit (if the `TaikoL1.commitBlock`
+// transaction is already confirmed).
+type commitSlotRecord struct {
+	CommitHash   common.Hash `json:"commitHash"`
+	CommitHeight uint64      `json:"commitHeight"`
+	AllocatedAt  uint64      `json:"allocatedAt"`
+}
+
+// commitSlotChecker checks whether the commit stored in the given slot may still be consumed by a
+// `TaikoL1.proposeBlock` transaction.
+type commitSlotChecker func(ctx context.Context, slot uint64, record *commitSlotRecord) (bool, error)
+
+// commitSlotAllocator allocates TaikoL1 commit slots for the proposer, and makes sure a slot
+// won't be reused before the `TaikoL1.proposeBlock` transaction consuming its commit is settled.
+// If a state file path is given, all in-use slots will be persisted, so they can survive restarts,
+// and the slots loaded from the state file will be checked on chain before being allocated again.
+type commitSlotAllocator struct {
+	baseSlot   uint64
+	statePath  string
+	inUse      map[uint64]*commitSlotRecord
+	loaded     map[uint64]bool // slots loaded from the state file, not used by this process
+	isOccupied commitSlotChecker
+	mutex      sync.Mutex
+}
+
+// newCommitSlotAllocator creates a new commit slot allocator instance, and loads all in-use
+// slots from the given state file, if there is one.
+func newCommitSlotAllocator(
+	baseSlot uint64,
+	statePath string,
+	isOccupied commitSlotChecker,
+) (*commitSlotAllocator, error) {
+	a := &commitSlotAllocator{
+		baseSlot:   baseSlot,
+		statePath:  statePath,
+		inUse:      make(map[uint64]*commitSlotRecord),
+		loaded:     make(map[uint64]bool),
+		isOccupied: isOccupied,
+	}
+
+	if len(statePath) == 0 {
+		return a, nil
+	}
+
+	data, err := os.ReadFile(statePath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return a, nil
+		}
+		return nil, fmt.Errorf("failed to read commit slots state file: %w", err)
+	}
+
+	if err := json.Unmarshal(data, &a.inUse); err != nil {
+		return nil, fmt.Errorf("failed to decode commit slots state file: %w", err)
+	}
+
+	if a.inUse == nil {
+		a.inUse = make(map[uint64]*commitSlotRecord)
+	}
+	for slot := range a.inUse {
+		a.loaded[slot] = true
+	}
+
+	return a, nil
+}
+
+// allocate returns the first free slot starting from the base slot, and marks it as in use by the
+// given commit. A slot loaded from the state file is free only if its commit can't be consumed any more.
+// At most maxCommitSlotsChecked slots will be checked, and the slots never wrap around.
+func (a *commitSlotAllocator) allocate(ctx context.Context, commitHash common.Hash, l1Height uint64) (uint64, error) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	var (
+		slot  = a.baseSlot
+		found bool
+	)
+	for i := 0; i < maxCommitSlotsChecked && slot >= a.baseSlot; i, slot = i+1, slot+1 {
+		record, ok := a.inUse[slot]
+		if !ok {
+			found = true
+			break
+		}
+		if !a.loaded[slot] {
+			continue
+		}
+
+		occupied, err := a.isOccupied(ctx, slot, record)
+		if err != nil {
+			return 0, fmt.Errorf("failed to check commit slot %d: %w", slot, err)
+		}
+		if !occupied {
+			delete(a.loaded, slot)
+			found = true
+			break
+		}
+
+		log.Info("Commit slot still occupied", "slot", slot, "commitHeight", record.CommitHeight)
+	}
+
+	if !found {
+		return 0, fmt.Errorf("no free commit slot found starting from slot %d", a.baseSlot)
+	}
+
+	previous := a.inUse[slot]
+	a.inUse[slot] = &commitSlotRecord{CommitHash: commitHash, AllocatedAt: l1Height}
+
+	if err := a.persist(); err != nil {
+		if previous != nil {
+			a.inUse[slot], a.loaded[slot] = previous, true
+		} else {
+			delete(a.inUse, slot)
+		}
+		return 0, err
+	}
+
+	log.Debug("Commit slot allocated", "slot", slot, "inUse", len(a.inUse))
+
+	return slot, nil
+}
+
+// markCommitted records the L1 height of the confirmed commit stored in the given slot.
+func (a *commitSlotAllocator) markCommitted(slot uint64, commitHeight uint64) error {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	record, ok := a.inUse[slot]
+	if !ok {
+		return fmt.Errorf("commit slot %d is not allocated", slot)
+	}
+
+	record.CommitHeight = commitHeight
+
+	return a.persist()
+}
+
+// release marks the given slot as free, so it can be recycled.
+func (a *commitSlotAllocator) release(slot uint64) error {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	if _, ok := a.inUse[slot]; !ok {
+		return nil
+	}
+
+	delete(a.inUse, slot)
+	delete(a.loaded, slot)
+
+	log.Debug("Commit slot released", "slot", slot, "inUse", len(a.inUse))
+
+	return a.persist()
+}
+
+// records returns a copy of all in-use slots.
+func (a *commitSlotAllocator) records() map[uint64]commitSlotRecord {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	records := make(map[uint64]commitSlotRecord, len(a.inUse))
+	for slot, record := range a.inUse {
+		records[slot] = *record
+	}
+
+	return records
+}
+
+// persist writes all in-use slots to the state file, if there is one.
+func (a *commitSlotAllocator) persist() error {
+	if len(a.statePath) == 0 {
+		return nil
+	}
+
+	data, err := json.Marshal(a.inUse)
+	if err != nil {
+		return fmt.Errorf("failed to encode commit slots state: %w", err)
+	}
+
+	if err := fileUtil.WriteFileAtomic(a.statePath, data, 0600); err != nil {
+		return fmt.Errorf("failed to write commit slots state file: %w", err)
+	}
+
+	return nil
+}
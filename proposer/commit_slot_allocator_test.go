@@ -0,0 +1,119 @@
+package proposer
+
+import (
+	"context"
+	"math"
+	"path/filepath"
+
+	"github.com/taikoxyz/taiko-client/testutils"
+)
+
+func (s *ProposerTestSuite) TestCommitSlotAllocator() {
+	statePath := filepath.Join(s.T().TempDir(), "commitSlots.json")
+
+	occupiedSlots := make(map[uint64]bool)
+	isOccupied := func(ctx context.Context, slot uint64, record *commitSlotRecord) (bool, error) {
+		return occupiedSlots[slot], nil
+	}
+
+	allocator, err := newCommitSlotAllocator(1024, statePath, isOccupied)
+	s.Nil(err)
+
+	// Slots in use should never be allocated again.
+	commitHash := testutils.RandomHash()
+	slot0, err := allocator.allocate(context.Background(), commitHash, 1)
+	s.Nil(err)
+	s.Equal(uint64(1024), slot0)
+
+	slot1, err := allocator.allocate(context.Background(), commitHash, 1)
+	s.Nil(err)
+	s.Equal(uint64(1025), slot1)
+
+	// Released slots should be recycled.
+	s.Nil(allocator.release(slot0))
+
+	slot2, err := allocator.allocate(context.Background(), commitHash, 1)
+	s.Nil(err)
+	s.Equal(slot0, slot2)
+
+	s.Nil(allocator.markCommitted(slot1, 2))
+	s.NotNil(allocator.markCommitted(2048, 2))
+
+	// In-use slots should survive restarts.
+	reloaded, err := newCommitSlotAllocator(1024, statePath, isOccupied)
+	s.Nil(err)
+
+	records := reloaded.records()
+	s.Equal(2, len(records))
+	s.Equal(commitHash, records[slot1].CommitHash)
+	s.Equal(uint64(2), records[slot1].CommitHeight)
+	s.Equal(uint64(1), records[slot1].AllocatedAt)
+
+	// Loaded slots should only be allocated again after their commits can't be consumed.
+	occupiedSlots[slot0] = true
+	slot3, err := reloaded.allocate(context.Background(), commitHash, 3)
+	s.Nil(err)
+	s.Equal(slot1, slot3)
+
+	slot4, err := reloaded.allocate(context.Background(), commitHash, 3)
+	s.Nil(err)
+	s.Equal(uint64(1026), slot4)
+}
+
+func (s *ProposerTestSuite) TestCommitSlotAllocatorExhausted() {
+	isOccupied := func(ctx context.Context, slot uint64, record *commitSlotRecord) (bool, error) {
+		return true, nil
+	}
+
+	// Slots should never wrap around.
+	allocator, err := newCommitSlotAllocator(math.MaxUint64-1, "", isOccupied)
+	s.Nil(err)
+
+	slot0, err := allocator.allocate(context.Background(), testutils.RandomHash(), 1)
+	s.Nil(err)
+	s.Equal(uint64(math.MaxUint64-1), slot0)
+
+	slot1, err := allocator.allocate(context.Background(), testutils.RandomHash(), 1)
+	s.Nil(err)
+	s.Equal(uint64(math.MaxUint64), slot1)
+
+	_, err = allocator.allocate(context.Background(), testutils.RandomHash(), 1)
+	s.NotNil(err)
+
+	// At most maxCommitSlotsChecked slots should be checked.
+	allocator, err = newCommitSlotAllocator(0, "", isOccupied)
+	s.Nil(err)
+
+	for i := 0; i < maxCommitSlotsChecked; i++ {
+		_, err := allocator.allocate(context.Background(), testutils.RandomHash(), 1)
+		s.Nil(err)
+	}
+
+	_, err = allocator.allocate(context.Background(), testutils.RandomHash(), 1)
+	s.NotNil(err)
+}
+
+func (s *ProposerTestSuite) TestIsCommitSlotOccupied() {
+	// The commit transaction has never been sent.
+	occupied, err := s.p.isCommitSlotOccupied(context.Background(), 1024, &commitSlotRecord{})
+	s.Nil(err)
+	s.False(occupied)
+
+	// The commit transaction has never been confirmed.
+	occupied, err = s.p.isCommitSlotOccupied(
+		context.Background(),
+		1024,
+		&commitSlotRecord{CommitHash: testutils.RandomHash()},
+	)
+	s.Nil(err)
+	s.False(occupied)
+
+	// No commit has ever been stored in this slot.
+	occupied, err = s.p.isCommitSlotOccupied(
+		context.Background(),
+		1024,
+		&commitSlotRecord{CommitHash: testutils.RandomHash(), CommitHeight: 1},
+	)
+	s.Nil(err)
+	s.False(occupied)
+}
@@ -3,6 +3,8 @@ package proposer
 import (
 	"crypto/ecdsa"
 	"fmt"
+	"math/big"
+	"strings"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -13,15 +15,32 @@ import (
 
 // Config contains all configurations to initialize a Taiko proposer.
 type Config struct {
-	L1Endpoint              string
-	L2Endpoint              string
-	TaikoL1Address          common.Address
-	TaikoL2Address          common.Address
-	L1ProposerPrivKey       *ecdsa.PrivateKey
-	L2SuggestedFeeRecipient common.Address
-	ProposeInterval         *time.Duration
-	ShufflePoolContent      bool
-	CommitSlot              uint64
+	L1Endpoint                 string
+	L1FallbackEndpoints        []string
+	L1HealthCheckInterval      time.Duration
+	L1MaxHeadLag               uint64
+	L1RateLimit                uint64
+	L1LogsRateLimit            uint64
+	L2RateLimit                uint64
+	L2LogsRateLimit            uint64
+	L2Endpoint                 string
+	TaikoL1Address             common.Address
+	TaikoL2Address             common.Address
+	L1ProposerPrivKey          *ecdsa.PrivateKey
+	L2SuggestedFeeRecipient    common.Address
+	ProposeInterval            *time.Duration
+	ShufflePoolContent         bool
+	CommitSlot                 uint64
+	CommitSlotStateFile        string
+	MaxDerivationLag           uint64
+	ProposeTxsThreshold        uint64
+	ProposeGasThreshold        uint64
+	ProposeMaxLatency          *time.Duration
+	L1SlotWindowLowerBound     *time.Duration
+	L1SlotWindowUpperBound     *time.Duration
+	ProposeEmptyBlocksInterval *time.Duration
+	EmptyBlocksMaxGasPrice     *big.Int
+	SimulateTxLists            bool
 }
 
 // NewConfigFromCliContext initializes a Config instance from
@@ -44,20 +63,90 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 		proposingInterval = &interval
 	}
 
+	var proposeMaxLatency *time.Duration
+	if c.IsSet(flags.ProposeMaxLatency.Name) {
+		latency, err := time.ParseDuration(c.String(flags.ProposeMaxLatency.Name))
+		if err != nil {
+			return nil, fmt.Errorf("invalid proposing max latency: %w", err)
+		}
+		proposeMaxLatency = &latency
+	}
+
+	var (
+		l1SlotWindowLowerBound *time.Duration
+		l1SlotWindowUpperBound *time.Duration
+	)
+	if c.IsSet(flags.ProposeL1SlotWindow.Name) {
+		flagValue := c.String(flags.ProposeL1SlotWindow.Name)
+		splitted := strings.Split(flagValue, "-")
+		if len(splitted) != 2 {
+			return nil, fmt.Errorf("invalid L1 slot proposing window value: %s", flagValue)
+		}
+
+		lower, err := time.ParseDuration(splitted[0])
+		if err != nil {
+			return nil, fmt.Errorf("invalid L1 slot proposing window value: %s, err: %w", flagValue, err)
+		}
+		upper, err := time.ParseDuration(splitted[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid L1 slot proposing window value: %s, err: %w", flagValue, err)
+		}
+		if lower > upper {
+			return nil, fmt.Errorf("invalid L1 slot proposing window value (lower > upper): %s", flagValue)
+		}
+		if upper >= l1SlotDuration {
+			return nil, fmt.Errorf("invalid L1 slot proposing window value (upper >= %s): %s", l1SlotDuration, flagValue)
+		}
+
+		l1SlotWindowLowerBound = &lower
+		l1SlotWindowUpperBound = &upper
+	}
+
+	var proposeEmptyBlocksInterval *time.Duration
+	if c.IsSet(flags.ProposeEmptyBlocksInterval.Name) {
+		interval, err := time.ParseDuration(c.String(flags.ProposeEmptyBlocksInterval.Name))
+		if err != nil {
+			return nil, fmt.Errorf("invalid proposing empty blocks interval: %w", err)
+		}
+		proposeEmptyBlocksInterval = &interval
+	}
+
+	var emptyBlocksMaxGasPrice *big.Int
+	if c.IsSet(flags.EmptyBlocksMaxGasPrice.Name) {
+		emptyBlocksMaxGasPrice = new(big.Int).SetUint64(c.Uint64(flags.EmptyBlocksMaxGasPrice.Name))
+	}
+
 	l2SuggestedFeeRecipient := c.String(flags.L2SuggestedFeeRecipient.Name)
 	if !common.IsHexAddress(l2SuggestedFeeRecipient) {
 		return nil, fmt.Errorf("invalid L2 suggested fee recipient address: %s", l2SuggestedFeeRecipient)
 	}
 
 	return &Config{
-		L1Endpoint:              c.String(flags.L1WSEndpoint.Name),
-		L2Endpoint:              c.String(flags.L2WSEndpoint.Name),
-		TaikoL1Address:          common.HexToAddress(c.String(flags.TaikoL1Address.Name)),
-		TaikoL2Address:          common.HexToAddress(c.String(flags.TaikoL2Address.Name)),
-		L1ProposerPrivKey:       l1ProposerPrivKey,
-		L2SuggestedFeeRecipient: common.HexToAddress(l2SuggestedFeeRecipient),
-		ProposeInterval:         proposingInterval,
-		ShufflePoolContent:      c.Bool(flags.ShufflePoolContent.Name),
-		CommitSlot:              c.Uint64(flags.CommitSlot.Name),
+		L1Endpoint:                 c.String(flags.L1WSEndpoint.Name),
+		L1FallbackEndpoints:        c.StringSlice(flags.L1WSFallbackEndpoints.Name),
+		L1HealthCheckInterval:      c.Duration(flags.L1HealthCheckInterval.Name),
+		L1MaxHeadLag:               c.Uint64(flags.L1MaxHeadLag.Name),
+		L1RateLimit:                c.Uint64(flags.L1RateLimit.Name),
+		L1LogsRateLimit:            c.Uint64(flags.L1LogsRateLimit.Name),
+		L2RateLimit:                c.Uint64(flags.L2RateLimit.Name),
+		L2LogsRateLimit:            c.Uint64(flags.L2LogsRateLimit.Name),
+		L2Endpoint:                 c.String(flags.L2WSEndpoint.Name),
+		TaikoL1Address:             common.HexToAddress(c.String(flags.TaikoL1Address.Name)),
+		TaikoL2Address:             common.HexToAddress(c.String(flags.TaikoL2Address.Name)),
+		L1ProposerPrivKey:          l1ProposerPrivKey,
+		L2SuggestedFeeRecipient:    common.HexToAddress(l2SuggestedFeeRecipient),
+		ProposeInterval:            proposingInterval,
+		ShufflePoolContent:         c.Bool(flags.ShufflePoolContent.Name),
+		CommitSlot:                 c.Uint64(flags.CommitSlot.Name),
+		CommitSlotStateFile:        c.String(flags.CommitSlotStateFile.Name),
+		MaxDerivationLag:           c.Uint64(flags.MaxDerivationLag.Name),
+		ProposeTxsThreshold:        c.Uint64(flags.ProposeTxsThreshold.Name),
+		ProposeGasThreshold:        c.Uint64(flags.ProposeGasThreshold.Name),
+		ProposeMaxLatency:          proposeMaxLatency,
+		L1SlotWindowLowerBound:     l1SlotWindowLowerBound,
+		L1SlotWindowUpperBound:     l1SlotWindowUpperBound,
+		ProposeEmptyBlocksInterval: proposeEmptyBlocksInterval,
+		EmptyBlocksMaxGasPrice:     emptyBlocksMaxGasPrice,
+		SimulateTxLists:            c.Bool(flags.SimulateTxLists.Name),
 	}, nil
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"os"
 	"strconv"
+	"time"
 
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/taikoxyz/taiko-client/bindings"
@@ -18,6 +19,11 @@ func (s *ProposerTestSuite) TestNewConfigFromCliContext() {
 	taikoL2 := os.Getenv("TAIKO_L2_ADDRESS")
 	proposeInterval := "10s"
 	commitSlot := 1024
+	commitSlotStateFile := "/tmp/commitSlots.json"
+	maxDerivationLag := 2
+	proposeTxsThreshold := 16
+	proposeMaxLatency := "30s"
+	proposeL1SlotWindow := "0s-4s"
 
 	app := cli.NewApp()
 	app.Flags = []cli.Flag{
@@ -29,6 +35,11 @@ func (s *ProposerTestSuite) TestNewConfigFromCliContext() {
 		&cli.StringFlag{Name: flags.L2SuggestedFeeRecipient.Name},
 		&cli.StringFlag{Name: flags.ProposeInterval.Name},
 		&cli.Uint64Flag{Name: flags.CommitSlot.Name},
+		&cli.StringFlag{Name: flags.CommitSlotStateFile.Name},
+		&cli.Uint64Flag{Name: flags.MaxDerivationLag.Name},
+		&cli.Uint64Flag{Name: flags.ProposeTxsThreshold.Name},
+		&cli.StringFlag{Name: flags.ProposeMaxLatency.Name},
+		&cli.StringFlag{Name: flags.ProposeL1SlotWindow.Name},
 	}
 	app.Action = func(ctx *cli.Context) error {
 		c, err := NewConfigFromCliContext(ctx)
@@ -41,6 +52,13 @@ func (s *ProposerTestSuite) TestNewConfigFromCliContext() {
 		s.Equal(bindings.GoldenTouchAddress, c.L2SuggestedFeeRecipient)
 		s.Equal(float64(10), c.ProposeInterval.Seconds())
 		s.Equal(uint64(commitSlot), c.CommitSlot)
+		s.Equal(commitSlotStateFile, c.CommitSlotStateFile)
+		s.Equal(uint64(maxDerivationLag), c.MaxDerivationLag)
+		s.Equal(uint64(proposeTxsThreshold), c.ProposeTxsThreshold)
+		s.Zero(c.ProposeGasThreshold)
+		s.Equal(30*time.Second, *c.ProposeMaxLatency)
+		s.Equal(time.Duration(0), *c.L1SlotWindowLowerBound)
+		s.Equal(4*time.Second, *c.L1SlotWindowUpperBound)
 		s.Nil(new(Proposer).InitFromCli(context.Background(), ctx))
 
 		return err
@@ -56,5 +74,10 @@ func (s *ProposerTestSuite) TestNewConfigFromCliContext() {
 		"-" + flags.L2SuggestedFeeRecipient.Name, bindings.GoldenTouchAddress.Hex(),
 		"-" + flags.ProposeInterval.Name, proposeInterval,
 		"-" + flags.CommitSlot.Name, strconv.Itoa(commitSlot),
+		"-" + flags.CommitSlotStateFile.Name, commitSlotStateFile,
+		"-" + flags.MaxDerivationLag.Name, strconv.Itoa(maxDerivationLag),
+		"-" + flags.ProposeTxsThreshold.Name, strconv.Itoa(proposeTxsThreshold),
+		"-" + flags.ProposeMaxLatency.Name, proposeMaxLatency,
+		"-" + flags.ProposeL1SlotWindow.Name, proposeL1SlotWindow,
 	}))
 }
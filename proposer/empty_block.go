@@ -0,0 +1,97 @@
+package proposer
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/taikoxyz/taiko-client/metrics"
+	"github.com/taikoxyz/taiko-client/pkg/rpc"
+)
+
+// proposeEmptyBlockOp proposes an empty transactions list if no block has been proposed in
+// TaikoL1 contract for a period, so that the L2 chain can still anchor a fresh L1 header.
+func (p *Proposer) proposeEmptyBlockOp(ctx context.Context) error {
+	if p.proposeEmptyBlocksInterval == nil {
+		return nil
+	}
+
+	state, err := p.rpc.TaikoL1.State(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return fmt.Errorf("failed to get TaikoL1 state: %w", err)
+	}
+
+	lastProposedAt := time.Unix(int64(state.LastProposedAt), 0)
+	if time.Since(lastProposedAt) < *p.proposeEmptyBlocksInterval {
+		return nil
+	}
+
+	exceeded, gasPrice, err := p.isEmptyBlockGasPriceExceeded(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to check empty block proposing gas price: %w", err)
+	}
+
+	if exceeded {
+		log.Info(
+			"Skip proposing an empty block, gas price exceeds the cap",
+			"gasPrice", gasPrice,
+			"cap", p.emptyBlocksMaxGasPrice,
+		)
+		metrics.ProposerSkippedEmptyBlocksCounter.Inc(1)
+		return nil
+	}
+
+	log.Info("Propose an empty block as heartbeat", "lastProposedAt", lastProposedAt)
+
+	txListBytes, err := rlp.EncodeToBytes(types.Transactions{})
+	if err != nil {
+		return fmt.Errorf("failed to encode empty transactions list: %w", err)
+	}
+
+	meta, commitTx, err := p.CommitTxList(ctx, txListBytes, 0)
+	if err != nil {
+		return fmt.Errorf("failed to commit empty transactions list: %w", err)
+	}
+
+	if err := p.ProposeTxList(ctx, meta, commitTx, txListBytes, 0); err != nil {
+		return fmt.Errorf("failed to propose empty transactions list: %w", err)
+	}
+
+	metrics.ProposerProposedEmptyBlocksCounter.Inc(1)
+
+	return nil
+}
+
+// isEmptyBlockGasPriceExceeded checks whether the current L1 gas price exceeds the configured
+// cost cap for proposing empty blocks.
+func (p *Proposer) isEmptyBlockGasPriceExceeded(ctx context.Context) (bool, *big.Int, error) {
+	if p.emptyBlocksMaxGasPrice == nil {
+		return false, nil, nil
+	}
+
+	l1Head, err := p.rpc.L1.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return false, nil, err
+	}
+
+	gasTipCap, err := p.rpc.L1.SuggestGasTipCap(ctx)
+	if err != nil {
+		if rpc.IsMaxPriorityFeePerGasNotFoundError(err) {
+			gasTipCap = rpc.FallbackGasTipCap
+		} else {
+			return false, nil, err
+		}
+	}
+
+	gasPrice := new(big.Int).Set(gasTipCap)
+	if l1Head.BaseFee != nil {
+		gasPrice.Add(gasPrice, l1Head.BaseFee)
+	}
+
+	return gasPrice.Cmp(p.emptyBlocksMaxGasPrice) > 0, gasPrice, nil
+}
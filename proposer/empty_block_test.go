@@ -0,0 +1,47 @@
+package proposer
+
+import (
+	"context"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/taikoxyz/taiko-client/bindings"
+)
+
+func (s *ProposerTestSuite) TestProposeEmptyBlockOp() {
+	// Heartbeat disabled.
+	s.Nil(s.p.proposeEmptyBlockOp(context.Background()))
+
+	interval := time.Duration(0)
+	s.p.proposeEmptyBlocksInterval = &interval
+	defer func() {
+		s.p.proposeEmptyBlocksInterval = nil
+		s.p.emptyBlocksMaxGasPrice = nil
+	}()
+
+	// Gas price exceeds the cap.
+	s.p.emptyBlocksMaxGasPrice = common.Big1
+	exceeded, gasPrice, err := s.p.isEmptyBlockGasPriceExceeded(context.Background())
+	s.Nil(err)
+	s.True(exceeded)
+	s.Greater(gasPrice.Uint64(), uint64(1))
+
+	// Propose an empty block.
+	s.p.emptyBlocksMaxGasPrice = nil
+
+	sink := make(chan *bindings.TaikoL1ClientBlockProposed)
+
+	sub, err := s.p.rpc.TaikoL1.WatchBlockProposed(nil, sink, nil)
+	s.Nil(err)
+	defer func() {
+		sub.Unsubscribe()
+		close(sink)
+	}()
+
+	s.Nil(s.p.proposeEmptyBlockOp(context.Background()))
+
+	event := <-sink
+	s.Equal(s.p.l2SuggestedFeeRecipient, event.Meta.Beneficiary)
+	s.Equal(crypto.Keccak256Hash([]byte{0xc0}), common.BytesToHash(event.Meta.TxListHash[:]))
+}
@@ -13,13 +13,14 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
-	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/event"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/taikoxyz/taiko-client/bindings"
 	"github.com/taikoxyz/taiko-client/bindings/encoding"
 	"github.com/taikoxyz/taiko-client/metrics"
 	"github.com/taikoxyz/taiko-client/pkg/rpc"
+	rpcErrors "github.com/taikoxyz/taiko-client/pkg/rpc_errors"
 	"github.com/urfave/cli/v2"
 )
 
@@ -30,14 +31,33 @@ type Proposer struct {
 
 	// Private keys and account addresses
 	l1ProposerPrivKey       *ecdsa.PrivateKey
+	l1ProposerAddress       common.Address
 	l2SuggestedFeeRecipient common.Address
 
 	// Proposing configuration
 	proposingInterval *time.Duration
 	proposingTimer    *time.Timer
-	commitSlot        uint64
+	proposeNotify     chan struct{}
+	maxDerivationLag  uint64
+
+	// Proposing triggers
+	proposeTxsThreshold    uint64
+	proposeGasThreshold    uint64
+	proposeMaxLatency      *time.Duration
+	l1SlotWindowLowerBound *time.Duration
+	l1SlotWindowUpperBound *time.Duration
+	pendingTxsTracker      *pendingTxsTracker
+	pendingTxsCh           chan common.Hash
+	pendingTxsSub          event.Subscription
+
+	// Empty blocks proposing
+	proposeEmptyBlocksInterval *time.Duration
+	emptyBlocksMaxGasPrice     *big.Int
+
+	commitSlotAllocator *commitSlotAllocator
 
 	poolContentSplitter *poolContentSplitter
+	txListSimulator     *txListSimulator
 
 	// Constants in LibConstants
 	protocolConstants *bindings.ProtocolConstants
@@ -63,29 +83,47 @@ func (p *Proposer) InitFromCli(ctx context.Context, c *cli.Context) error {
 // InitFromConfig initializes the proposer instance based on the given configurations.
 func InitFromConfig(ctx context.Context, p *Proposer, cfg *Config) (err error) {
 	p.l1ProposerPrivKey = cfg.L1ProposerPrivKey
+	p.l1ProposerAddress = crypto.PubkeyToAddress(cfg.L1ProposerPrivKey.PublicKey)
 	p.l2SuggestedFeeRecipient = cfg.L2SuggestedFeeRecipient
 	p.proposingInterval = cfg.ProposeInterval
+	p.maxDerivationLag = cfg.MaxDerivationLag
+	p.proposeNotify = make(chan struct{}, 1)
+	p.proposeTxsThreshold = cfg.ProposeTxsThreshold
+	p.proposeGasThreshold = cfg.ProposeGasThreshold
+	p.proposeMaxLatency = cfg.ProposeMaxLatency
+	p.l1SlotWindowLowerBound = cfg.L1SlotWindowLowerBound
+	p.l1SlotWindowUpperBound = cfg.L1SlotWindowUpperBound
+	p.pendingTxsTracker = newPendingTxsTracker()
+	p.pendingTxsCh = make(chan common.Hash, 1024)
+	p.proposeEmptyBlocksInterval = cfg.ProposeEmptyBlocksInterval
+	p.emptyBlocksMaxGasPrice = cfg.EmptyBlocksMaxGasPrice
 	p.wg = sync.WaitGroup{}
 	p.ctx = ctx
 
 	// RPC clients
 	if p.rpc, err = rpc.NewClient(p.ctx, &rpc.ClientConfig{
-		L1Endpoint:     cfg.L1Endpoint,
-		L2Endpoint:     cfg.L2Endpoint,
-		TaikoL1Address: cfg.TaikoL1Address,
-		TaikoL2Address: cfg.TaikoL2Address,
+		L1Endpoint:            cfg.L1Endpoint,
+		L1FallbackEndpoints:   cfg.L1FallbackEndpoints,
+		L1HealthCheckInterval: cfg.L1HealthCheckInterval,
+		L1MaxHeadLag:          cfg.L1MaxHeadLag,
+		L1RateLimit:           cfg.L1RateLimit,
+		L1LogsRateLimit:       cfg.L1LogsRateLimit,
+		L2RateLimit:           cfg.L2RateLimit,
+		L2LogsRateLimit:       cfg.L2LogsRateLimit,
+		L2Endpoint:            cfg.L2Endpoint,
+		TaikoL1Address:        cfg.TaikoL1Address,
+		TaikoL2Address:        cfg.TaikoL2Address,
 	}); err != nil {
 		return fmt.Errorf("initialize rpc clients error: %w", err)
 	}
 
-	proposerAddress := crypto.PubkeyToAddress(cfg.L1ProposerPrivKey.PublicKey)
-	isWhitelisted, err := p.rpc.IsProposerWhitelisted(proposerAddress)
+	isWhitelisted, err := p.rpc.IsProposerWhitelisted(p.l1ProposerAddress)
 	if err != nil {
-		return fmt.Errorf("failed to check whether current proposer %s is whitelisted: %w", proposerAddress, err)
+		return fmt.Errorf("failed to check whether current proposer %s is whitelisted: %w", p.l1ProposerAddress, err)
 	}
 
 	if !isWhitelisted {
-		return fmt.Errorf("proposer %s is not whitelisted", proposerAddress)
+		return fmt.Errorf("proposer %s is not whitelisted", p.l1ProposerAddress)
 	}
 
 	// Protocol constants
@@ -102,13 +140,30 @@ func InitFromConfig(ctx context.Context, p *Proposer, cfg *Config) (err error) {
 		txListMaxBytes:     p.protocolConstants.TxListMaxBytes.Uint64(),
 		txMinGasLimit:      p.protocolConstants.TxMinGasLimit.Uint64(),
 	}
-	p.commitSlot = cfg.CommitSlot
+
+	if cfg.SimulateTxLists {
+		p.txListSimulator = newTxListSimulator(p.rpc.L2, p.rpc.L2ChainID)
+	}
+
+	if p.commitSlotAllocator, err = newCommitSlotAllocator(
+		cfg.CommitSlot,
+		cfg.CommitSlotStateFile,
+		p.isCommitSlotOccupied,
+	); err != nil {
+		return fmt.Errorf("failed to initialize commit slot allocator: %w", err)
+	}
 
 	return nil
 }
 
 // Start starts the proposer's main loop.
 func (p *Proposer) Start() error {
+	if p.isTriggerEnabled() {
+		p.startPendingTxsSubscription()
+		p.wg.Add(1)
+		go p.triggerLoop()
+	}
+
 	p.wg.Add(1)
 	go p.eventLoop()
 	return nil
@@ -128,18 +183,34 @@ func (p *Proposer) eventLoop() {
 		case <-p.ctx.Done():
 			return
 		case <-p.proposingTimer.C:
-			metrics.ProposerProposeEpochCounter.Inc(1)
-
-			if err := p.ProposeOp(p.ctx); err != nil {
-				log.Error("Proposing operation error", "error", err)
-				continue
-			}
+			p.proposeEpoch()
+		case <-p.proposeNotify:
+			p.proposeEpoch()
 		}
 	}
 }
 
+// proposeEpoch performs a proposing operation within the configured L1 slot window.
+func (p *Proposer) proposeEpoch() {
+	metrics.ProposerProposeEpochCounter.Inc(1)
+
+	if err := p.waitL1SlotWindow(p.ctx); err != nil {
+		log.Error("Wait for L1 slot proposing window error", "error", err)
+		return
+	}
+
+	removed := p.pendingTxsTracker.removedCount()
+	if err := p.ProposeOp(p.ctx); err != nil {
+		log.Error("Proposing operation error", "error", err)
+	}
+	p.pendingTxsTracker.onProposingFinished(p.pendingTxsTracker.removedCount() != removed, time.Now())
+}
+
 // Close closes the proposer instance.
 func (p *Proposer) Close() {
+	if p.pendingTxsSub != nil {
+		p.pendingTxsSub.Unsubscribe()
+	}
 	p.wg.Wait()
 }
 
@@ -147,7 +218,7 @@ type commitTxListRes struct {
 	meta        *bindings.LibDataBlockMetadata
 	commitTx    *types.Transaction
 	txListBytes []byte
-	txNum       uint
+	txs         types.Transactions
 }
 
 // ProposeOp performs a proposing operation, fetching transactions
@@ -166,6 +237,21 @@ func (p *Proposer) ProposeOp(ctx context.Context) error {
 		return nil
 	}
 
+	// Even if the beacon sync has finished, the driver may still be inserting the pending proposed
+	// blocks one by one, proposing transactions from a stale pool will result in invalid transactions lists.
+	derivationLag, err := p.l2DerivationLag(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get L2 execution engine derivation lag: %w", err)
+	}
+	if derivationLag > p.maxDerivationLag {
+		log.Info(
+			"L2 execution engine has not derived all proposed blocks yet",
+			"lag", derivationLag,
+			"maxLag", p.maxDerivationLag,
+		)
+		return nil
+	}
+
 	log.Info("Start fetching L2 execution engine's transaction pool content")
 
 	pendingContent, _, err := p.rpc.L2PoolContent(ctx)
@@ -173,17 +259,39 @@ func (p *Proposer) ProposeOp(ctx context.Context) error {
 		return fmt.Errorf("failed to fetch transaction pool content: %w", err)
 	}
 
+	// The recorded pending transactions which have left the pool will never be proposed.
+	p.pendingTxsTracker.retain(pendingContent)
+
 	log.Info("Fetching L2 pending transactions finished", "length", pendingContent.ToTxLists().Len())
 
+	txLists := p.poolContentSplitter.split(pendingContent)
+
+	// Simulate the candidate transactions lists, drop the transactions which would be skipped by
+	// the L2 execution engine, and then repack the remaining ones.
+	if p.txListSimulator != nil && len(txLists) > 0 {
+		executedContent, err := p.txListSimulator.simulate(ctx, txLists)
+		if err != nil {
+			return fmt.Errorf("failed to simulate transactions lists: %w", err)
+		}
+
+		txLists = p.poolContentSplitter.split(executedContent)
+	}
+
+	// No transaction to propose, try proposing an empty block as heartbeat.
+	if len(txLists) == 0 {
+		return p.proposeEmptyBlockOp(ctx)
+	}
+
 	var commitTxListResQueue []*commitTxListRes
-	for i, txs := range p.poolContentSplitter.split(pendingContent) {
+	for _, txs := range txLists {
 		txListBytes, err := rlp.EncodeToBytes(txs)
 		if err != nil {
 			return fmt.Errorf("failed to encode transactions: %w", err)
 		}
 
-		meta, commitTx, err := p.CommitTxList(ctx, txListBytes, sumTxsGasLimit(txs), i)
+		meta, commitTx, err := p.CommitTxList(ctx, txListBytes, sumTxsGasLimit(txs))
 		if err != nil {
+			p.releaseQueuedCommitSlots(commitTxListResQueue)
 			return fmt.Errorf("failed to commit transactions: %w", err)
 		}
 
@@ -191,7 +299,7 @@ func (p *Proposer) ProposeOp(ctx context.Context) error {
 			meta:        meta,
 			commitTx:    commitTx,
 			txListBytes: txListBytes,
-			txNum:       uint(len(txs)),
+			txs:         txs,
 		})
 	}
 
@@ -201,16 +309,21 @@ func (p *Proposer) ProposeOp(ctx context.Context) error {
 		}
 	}
 
-	for _, res := range commitTxListResQueue {
-		if err := p.ProposeTxList(ctx, res.meta, res.commitTx, res.txListBytes, res.txNum); err != nil {
+	for i, res := range commitTxListResQueue {
+		if err := p.ProposeTxList(ctx, res.meta, res.commitTx, res.txListBytes, uint(len(res.txs))); err != nil {
+			p.releaseQueuedCommitSlots(commitTxListResQueue[i+1:])
 			return fmt.Errorf("failed to propose transactions: %w", err)
 		}
+		p.pendingTxsTracker.remove(res.txs)
 	}
 
 	return nil
 }
 
-func (p *Proposer) CommitTxList(ctx context.Context, txListBytes []byte, gasLimit uint64, splittedIdx int) (
+// CommitTxList allocates a free commit slot, and commits the given transactions list
+// into that slot, the slot will be recycled after the list is proposed. No slot will be
+// allocated if the protocol doesn't require committing before proposing.
+func (p *Proposer) CommitTxList(ctx context.Context, txListBytes []byte, gasLimit uint64) (
 	*bindings.LibDataBlockMetadata,
 	*types.Transaction,
 	error,
@@ -223,29 +336,42 @@ func (p *Proposer) CommitTxList(ctx context.Context, txListBytes []byte, gasLimi
 		Beneficiary: p.l2SuggestedFeeRecipient,
 		GasLimit:    gasLimit,
 		TxListHash:  crypto.Keccak256Hash(txListBytes),
-		CommitSlot:  p.commitSlot + uint64(splittedIdx),
 	}
+	commitHash := common.BytesToHash(encoding.EncodeCommitHash(meta.Beneficiary, meta.TxListHash))
 
 	if p.protocolConstants.CommitDelayConfirmations.Cmp(common.Big0) == 0 {
 		log.Debug("No commit delay confirmation, skip committing transactions list")
 		return meta, nil, nil
 	}
 
-	opts, err := getTxOpts(ctx, p.rpc.L1, p.l1ProposerPrivKey, p.rpc.L1ChainID)
+	l1Head, err := p.rpc.L1.BlockNumber(ctx)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	commitHash := common.BytesToHash(encoding.EncodeCommitHash(meta.Beneficiary, meta.TxListHash))
+	commitSlot, err := p.commitSlotAllocator.allocate(ctx, commitHash, l1Head)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to allocate a commit slot: %w", err)
+	}
+	meta.CommitSlot = commitSlot
+
+	opts, err := getTxOpts(ctx, p.rpc.L1, p.l1ProposerPrivKey, p.rpc.L1ChainID)
+	if err != nil {
+		p.releaseCommitSlot(commitSlot)
+		return nil, nil, err
+	}
 
 	commitTx, err := p.rpc.TaikoL1.CommitBlock(opts, meta.CommitSlot, commitHash)
 	if err != nil {
+		p.releaseCommitSlot(commitSlot)
 		return nil, nil, err
 	}
 
 	return meta, commitTx, nil
 }
 
+// ProposeTxList proposes the given transactions list, which has been committed before,
+// to TaikoL1 contract.
 func (p *Proposer) ProposeTxList(
 	ctx context.Context,
 	meta *bindings.LibDataBlockMetadata,
@@ -253,6 +379,15 @@ func (p *Proposer) ProposeTxList(
 	txListBytes []byte,
 	txNum uint,
 ) error {
+	// Recycle the commit slot once this proposal is settled, unless the TaikoL1.proposeBlock
+	// transaction may still be included later, or no commit slot has been allocated at all.
+	keepCommitSlot := commitTx == nil
+	defer func() {
+		if !keepCommitSlot {
+			p.releaseCommitSlot(meta.CommitSlot)
+		}
+	}()
+
 	if p.protocolConstants.CommitDelayConfirmations.Cmp(common.Big0) > 0 {
 		receipt, err := rpc.WaitReceipt(ctx, p.rpc.L1, commitTx)
 		if err != nil {
@@ -272,6 +407,10 @@ func (p *Proposer) ProposeTxList(
 
 		meta.CommitHeight = receipt.BlockNumber.Uint64()
 
+		if err := p.commitSlotAllocator.markCommitted(meta.CommitSlot, meta.CommitHeight); err != nil {
+			return fmt.Errorf("failed to record commit slot %d: %w", meta.CommitSlot, err)
+		}
+
 		if err := rpc.WaitConfirmations(
 			ctx, p.rpc.L1, p.protocolConstants.CommitDelayConfirmations.Uint64(), receipt.BlockNumber.Uint64(),
 		); err != nil {
@@ -296,10 +435,11 @@ func (p *Proposer) ProposeTxList(
 	}
 
 	if _, err := rpc.WaitReceipt(ctx, p.rpc.L1, proposeTx); err != nil {
+		keepCommitSlot = ctx.Err() != nil
 		return err
 	}
 
-	log.Info("📝 Propose transactions succeeded")
+	log.Info("📝 Propose transactions succeeded", "commitSlot", meta.CommitSlot)
 
 	metrics.ProposerProposedTxListsCounter.Inc(1)
 	metrics.ProposerProposedTxsCounter.Inc(int64(txNum))
@@ -307,6 +447,113 @@ func (p *Proposer) ProposeTxList(
 	return nil
 }
 
+// l2DerivationLag calculates how many blocks proposed in TaikoL1 contract have not been
+// inserted into the L2 execution engine's local chain yet.
+func (p *Proposer) l2DerivationLag(ctx context.Context) (uint64, error) {
+	stateVars, err := p.rpc.GetProtocolStateVariables(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return 0, err
+	}
+
+	var headBlockID uint64
+	headL1Origin, err := p.rpc.L2.HeadL1Origin(ctx)
+	if err != nil {
+		if !rpcErrors.IsNotFound(err) {
+			return 0, err
+		}
+	} else if headL1Origin != nil {
+		headBlockID = headL1Origin.BlockID.Uint64()
+	}
+
+	var (
+		lastProposedBlockID = stateVars.NextBlockID - 1
+		lag                 uint64
+	)
+	if lastProposedBlockID > headBlockID {
+		lag = lastProposedBlockID - headBlockID
+	}
+
+	metrics.ProposerL2DerivationLagGauge.Update(int64(lag))
+
+	return lag, nil
+}
+
+// releaseCommitSlot recycles the given commit slot.
+func (p *Proposer) releaseCommitSlot(slot uint64) {
+	if err := p.commitSlotAllocator.release(slot); err != nil {
+		log.Error("Failed to release commit slot", "slot", slot, "error", err)
+	}
+}
+
+// releaseQueuedCommitSlots recycles the commit slots of the given committed transactions lists,
+// which will not be proposed.
+func (p *Proposer) releaseQueuedCommitSlots(queue []*commitTxListRes) {
+	for _, res := range queue {
+		if res.commitTx == nil {
+			continue
+		}
+		p.releaseCommitSlot(res.meta.CommitSlot)
+	}
+}
+
+// isCommitSlotOccupied checks whether the commit stored in the given slot may still be
+// consumed by a TaikoL1.proposeBlock transaction.
+func (p *Proposer) isCommitSlotOccupied(ctx context.Context, slot uint64, record *commitSlotRecord) (bool, error) {
+	if p.protocolConstants.CommitDelayConfirmations.Cmp(common.Big0) == 0 {
+		return false, nil
+	}
+
+	// The process might exit before recording the confirmed TaikoL1.commitBlock transaction,
+	// look for its BlockCommitted event.
+	if record.CommitHeight == 0 && record.CommitHash != (common.Hash{}) {
+		commitHeight, err := p.findCommitHeight(ctx, slot, record)
+		if err != nil {
+			return false, err
+		}
+		record.CommitHeight = commitHeight
+	}
+
+	// The TaikoL1.commitBlock transaction has never been confirmed, no one will consume this slot.
+	if record.CommitHeight == 0 {
+		return false, nil
+	}
+
+	l1Head, err := p.rpc.L1.BlockNumber(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	// Not enough confirmations yet, TaikoL1 contract will always consider this commit invalid.
+	if l1Head < record.CommitHeight+p.protocolConstants.CommitDelayConfirmations.Uint64() {
+		return true, nil
+	}
+
+	return p.rpc.TaikoL1.IsCommitValid(
+		&bind.CallOpts{From: p.l1ProposerAddress, Context: ctx},
+		new(big.Int).SetUint64(slot),
+		new(big.Int).SetUint64(record.CommitHeight),
+		record.CommitHash,
+	)
+}
+
+// findCommitHeight looks for the given commit in the BlockCommitted events since the slot was
+// allocated, and returns its L1 height, or zero if not found.
+func (p *Proposer) findCommitHeight(ctx context.Context, slot uint64, record *commitSlotRecord) (uint64, error) {
+	iter, err := p.rpc.TaikoL1.FilterBlockCommitted(&bind.FilterOpts{Start: record.AllocatedAt, Context: ctx})
+	if err != nil {
+		return 0, err
+	}
+	defer iter.Close()
+
+	for iter.Next() {
+		if iter.Event.CommitSlot == slot && iter.Event.CommitHash == record.CommitHash {
+			return iter.Event.CommitHeight, nil
+		}
+	}
+
+	return 0, iter.Error()
+}
+
 // updateProposingTicker updates the internal proposing timer.
 func (p *Proposer) updateProposingTicker() {
 	if p.proposingTimer != nil {
@@ -342,7 +589,7 @@ func sumTxsGasLimit(txs []*types.Transaction) uint64 {
 // getTxOpts creates a bind.TransactOpts instance using the given private key.
 func getTxOpts(
 	ctx context.Context,
-	cli *ethclient.Client,
+	cli *rpc.FailoverClient,
 	privKey *ecdsa.PrivateKey,
 	chainID *big.Int,
 ) (*bind.TransactOpts, error) {
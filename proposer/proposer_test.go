@@ -125,7 +125,7 @@ func (s *ProposerTestSuite) TestCommitTxList() {
 	txListBytes := testutils.RandomBytes(1024)
 	gasLimit := uint64(102400)
 
-	meta, tx, err := s.p.CommitTxList(context.Background(), txListBytes, gasLimit, 0)
+	meta, tx, err := s.p.CommitTxList(context.Background(), txListBytes, gasLimit)
 	s.Nil(err)
 	s.Equal(meta.GasLimit, gasLimit)
 
@@ -136,6 +136,12 @@ func (s *ProposerTestSuite) TestCommitTxList() {
 	}
 }
 
+func (s *ProposerTestSuite) TestL2DerivationLag() {
+	lag, err := s.p.l2DerivationLag(context.Background())
+	s.Nil(err)
+	s.Zero(lag)
+}
+
 func (s *ProposerTestSuite) TestCustomProposeOpHook() {
 	flag := false
 
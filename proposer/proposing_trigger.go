@@ -0,0 +1,292 @@
+package proposer
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/taikoxyz/taiko-client/metrics"
+	"github.com/taikoxyz/taiko-client/pkg/rpc"
+)
+
+const (
+	// Duration of a L1 slot, used to calculate the offset in current L1 slot.
+	l1SlotDuration = 12 * time.Second
+	// Interval to check whether the oldest pending transaction reaches the max latency.
+	maxLatencyCheckInterval = time.Second
+	// Backoff bounds of the triggers after a proposing operation which proposes no pending transaction.
+	proposingTriggerMinBackoff = 2 * time.Second
+	proposingTriggerMaxBackoff = time.Minute
+)
+
+// pendingTx represents a pending transaction seen by the pendingTxsTracker.
+type pendingTx struct {
+	gas    uint64
+	seenAt time.Time
+}
+
+// pendingTxsTracker keeps track of the transactions newly added to the L2 execution engine's
+// transaction pool, which have not been proposed yet. After a proposing operation which proposes
+// none of them, e.g. the L2 execution engine is still syncing, the triggers are backed off.
+type pendingTxsTracker struct {
+	txs          map[common.Hash]*pendingTx
+	gas          uint64
+	removed      uint64 // number of all removed transactions, which have been proposed
+	backoff      time.Duration
+	backoffUntil time.Time
+	mutex        sync.Mutex
+}
+
+// newPendingTxsTracker creates a new pending transactions tracker instance.
+func newPendingTxsTracker() *pendingTxsTracker {
+	return &pendingTxsTracker{txs: make(map[common.Hash]*pendingTx)}
+}
+
+// add records a newly seen pending transaction.
+func (t *pendingTxsTracker) add(hash common.Hash, gas uint64, seenAt time.Time) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if _, ok := t.txs[hash]; ok {
+		return
+	}
+
+	t.txs[hash] = &pendingTx{gas: gas, seenAt: seenAt}
+	t.gas += gas
+}
+
+// remove removes the given recorded pending transactions, after they have been proposed.
+func (t *pendingTxsTracker) remove(txs types.Transactions) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	for _, tx := range txs {
+		if pending, ok := t.txs[tx.Hash()]; ok {
+			delete(t.txs, tx.Hash())
+			t.gas -= pending.gas
+			t.removed++
+		}
+	}
+}
+
+// retain removes the recorded pending transactions which are no longer in the given transaction
+// pool content, e.g. the replaced ones, which will never be proposed.
+func (t *pendingTxsTracker) retain(content rpc.PoolContent) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	inPool := make(map[common.Hash]struct{})
+	for _, txs := range content {
+		for _, tx := range txs {
+			inPool[tx.Hash()] = struct{}{}
+		}
+	}
+
+	for hash, pending := range t.txs {
+		if _, ok := inPool[hash]; !ok {
+			delete(t.txs, hash)
+			t.gas -= pending.gas
+		}
+	}
+}
+
+// removedCount returns the number of all removed transactions, which have been proposed.
+func (t *pendingTxsTracker) removedCount() uint64 {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	return t.removed
+}
+
+// onProposingFinished backs off the triggers if the finished proposing operation has proposed none
+// of the recorded pending transactions, or resets the backoff otherwise.
+func (t *pendingTxsTracker) onProposingFinished(proposed bool, now time.Time) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if proposed || len(t.txs) == 0 {
+		t.backoff, t.backoffUntil = 0, time.Time{}
+		return
+	}
+
+	t.backoff *= 2
+	if t.backoff < proposingTriggerMinBackoff {
+		t.backoff = proposingTriggerMinBackoff
+	}
+	if t.backoff > proposingTriggerMaxBackoff {
+		t.backoff = proposingTriggerMaxBackoff
+	}
+	t.backoffUntil = now.Add(t.backoff)
+}
+
+// status returns the number, accumulated gas limit and the oldest seen time of all recorded
+// pending transactions, and whether the triggers are backed off at the given time.
+func (t *pendingTxsTracker) status(now time.Time) (uint64, uint64, *time.Time, bool) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	var oldestAt *time.Time
+	for _, tx := range t.txs {
+		if oldestAt == nil || tx.seenAt.Before(*oldestAt) {
+			seenAt := tx.seenAt
+			oldestAt = &seenAt
+		}
+	}
+
+	return uint64(len(t.txs)), t.gas, oldestAt, now.Before(t.backoffUntil)
+}
+
+// isTriggerEnabled checks whether any proposing trigger is configured.
+func (p *Proposer) isTriggerEnabled() bool {
+	return p.proposeTxsThreshold != 0 || p.proposeGasThreshold != 0 || p.proposeMaxLatency != nil
+}
+
+// shouldTriggerProposing checks whether the recorded pending transactions reach any
+// of the configured proposing triggers, and returns the reason if so.
+func (p *Proposer) shouldTriggerProposing(now time.Time) (bool, string) {
+	count, gas, oldestAt, backedOff := p.pendingTxsTracker.status(now)
+	if backedOff {
+		return false, ""
+	}
+
+	if p.proposeTxsThreshold != 0 && count >= p.proposeTxsThreshold {
+		return true, "txs"
+	}
+
+	if p.proposeGasThreshold != 0 && gas >= p.proposeGasThreshold {
+		return true, "gas"
+	}
+
+	if p.proposeMaxLatency != nil && oldestAt != nil && now.Sub(*oldestAt) >= *p.proposeMaxLatency {
+		return true, "latency"
+	}
+
+	return false, ""
+}
+
+// triggerLoop watches the L2 execution engine's transaction pool, and requests a proposing operation
+// once any of the configured triggers is reached.
+func (p *Proposer) triggerLoop() {
+	ticker := time.NewTicker(maxLatencyCheckInterval)
+	defer func() {
+		ticker.Stop()
+		p.wg.Done()
+	}()
+
+	checkTriggers := func() {
+		triggered, reason := p.shouldTriggerProposing(time.Now())
+		if !triggered {
+			return
+		}
+
+		select {
+		case p.proposeNotify <- struct{}{}:
+			log.Debug("Proposing triggered", "reason", reason)
+			metrics.ProposerTriggeredProposeCounter.Inc(1)
+		default:
+		}
+	}
+
+	for {
+		select {
+		case <-p.ctx.Done():
+			return
+		case hash := <-p.pendingTxsCh:
+			tx, _, err := p.rpc.L2.TransactionByHash(p.ctx, hash)
+			if err != nil {
+				log.Debug("Failed to fetch new pending transaction", "hash", hash, "error", err)
+				continue
+			}
+
+			p.pendingTxsTracker.add(hash, tx.Gas(), time.Now())
+			checkTriggers()
+		case <-ticker.C:
+			checkTriggers()
+		}
+	}
+}
+
+// watchPendingTransactions watches the newly added transactions in L2 execution engine's
+// transaction pool.
+func (p *Proposer) watchPendingTransactions(ctx context.Context) (event.Subscription, error) {
+	sub, err := p.rpc.SubscribeL2PendingTransactions(ctx, p.pendingTxsCh)
+	if err != nil {
+		log.Error("Create L2 pending transactions subscription error", "error", err)
+		return nil, err
+	}
+
+	defer sub.Unsubscribe()
+
+	select {
+	case err := <-sub.Err():
+		return sub, err
+	case <-ctx.Done():
+		return sub, nil
+	}
+}
+
+// startPendingTxsSubscription initializes the L2 pending transactions subscription.
+func (p *Proposer) startPendingTxsSubscription() {
+	p.pendingTxsSub = event.ResubscribeErr(
+		backoff.DefaultMaxInterval,
+		func(ctx context.Context, err error) (event.Subscription, error) {
+			if err != nil {
+				log.Warn("Failed to subscribe L2 pending transactions, try resubscribing", "error", err)
+			}
+
+			return p.watchPendingTransactions(ctx)
+		},
+	)
+}
+
+// l1SlotWindowDelay calculates how long to wait before the offset in current L1 slot enters the
+// configured proposing window, based on the given L1 head timestamp.
+func (p *Proposer) l1SlotWindowDelay(l1HeadTime uint64, now time.Time) time.Duration {
+	if p.l1SlotWindowLowerBound == nil || p.l1SlotWindowUpperBound == nil {
+		return 0
+	}
+
+	elapsed := now.Sub(time.Unix(int64(l1HeadTime), 0))
+	if elapsed < 0 {
+		elapsed = 0
+	}
+	offset := elapsed % l1SlotDuration
+
+	if offset >= *p.l1SlotWindowLowerBound && offset <= *p.l1SlotWindowUpperBound {
+		return 0
+	}
+
+	return (*p.l1SlotWindowLowerBound - offset + l1SlotDuration) % l1SlotDuration
+}
+
+// waitL1SlotWindow waits until the offset in current L1 slot enters the configured proposing window,
+// so the proposing transactions can land soon after new L1 blocks.
+func (p *Proposer) waitL1SlotWindow(ctx context.Context) error {
+	if p.l1SlotWindowLowerBound == nil || p.l1SlotWindowUpperBound == nil {
+		return nil
+	}
+
+	l1Head, err := p.rpc.L1.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	delay := p.l1SlotWindowDelay(l1Head.Time, time.Now())
+	if delay == 0 {
+		return nil
+	}
+
+	log.Debug("Wait for L1 slot proposing window", "delay", delay, "l1Head", l1Head.Number)
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(delay):
+		return nil
+	}
+}
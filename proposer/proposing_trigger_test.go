@@ -0,0 +1,122 @@
+package proposer
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/taikoxyz/taiko-client/pkg/rpc"
+)
+
+func (s *ProposerTestSuite) TestShouldTriggerProposing() {
+	var (
+		now        = time.Now()
+		maxLatency = 10 * time.Second
+		newTx      = func(nonce uint64) *types.Transaction {
+			return types.NewTransaction(nonce, common.Address{}, common.Big0, 21000, common.Big1, nil)
+		}
+		tx1 = newTx(1)
+		tx2 = newTx(2)
+	)
+
+	s.p.pendingTxsTracker = newPendingTxsTracker()
+	defer func() {
+		s.p.pendingTxsTracker = newPendingTxsTracker()
+		s.p.proposeTxsThreshold = 0
+		s.p.proposeGasThreshold = 0
+		s.p.proposeMaxLatency = nil
+	}()
+
+	// No trigger configured.
+	s.False(s.p.isTriggerEnabled())
+	s.p.pendingTxsTracker.add(tx1.Hash(), tx1.Gas(), now)
+	triggered, _ := s.p.shouldTriggerProposing(now)
+	s.False(triggered)
+
+	// Transactions number threshold.
+	s.p.proposeTxsThreshold = 2
+	s.True(s.p.isTriggerEnabled())
+	triggered, _ = s.p.shouldTriggerProposing(now)
+	s.False(triggered)
+
+	// The same transaction should only be recorded once.
+	s.p.pendingTxsTracker.add(tx1.Hash(), tx1.Gas(), now)
+	triggered, _ = s.p.shouldTriggerProposing(now)
+	s.False(triggered)
+
+	s.p.pendingTxsTracker.add(tx2.Hash(), tx2.Gas(), now.Add(time.Second))
+	triggered, reason := s.p.shouldTriggerProposing(now)
+	s.True(triggered)
+	s.Equal("txs", reason)
+
+	// Gas threshold.
+	s.p.proposeTxsThreshold = 0
+	s.p.proposeGasThreshold = 42000
+	triggered, reason = s.p.shouldTriggerProposing(now)
+	s.True(triggered)
+	s.Equal("gas", reason)
+
+	// Max latency.
+	s.p.proposeGasThreshold = 0
+	s.p.proposeMaxLatency = &maxLatency
+	triggered, _ = s.p.shouldTriggerProposing(now.Add(maxLatency - time.Second))
+	s.False(triggered)
+	triggered, reason = s.p.shouldTriggerProposing(now.Add(maxLatency))
+	s.True(triggered)
+	s.Equal("latency", reason)
+
+	// The triggers should be backed off after a proposing operation which proposes nothing.
+	s.p.pendingTxsTracker.onProposingFinished(false, now.Add(maxLatency))
+	triggered, _ = s.p.shouldTriggerProposing(now.Add(maxLatency + time.Second))
+	s.False(triggered)
+	triggered, _ = s.p.shouldTriggerProposing(now.Add(maxLatency + proposingTriggerMinBackoff))
+	s.True(triggered)
+
+	s.p.pendingTxsTracker.onProposingFinished(false, now.Add(maxLatency))
+	triggered, _ = s.p.shouldTriggerProposing(now.Add(maxLatency + proposingTriggerMinBackoff))
+	s.False(triggered)
+
+	// Only the proposed transactions are removed, and the backoff is reset.
+	s.p.pendingTxsTracker.remove(types.Transactions{tx1, newTx(3)})
+	s.p.pendingTxsTracker.onProposingFinished(true, now.Add(maxLatency))
+	count, gas, oldestAt, backedOff := s.p.pendingTxsTracker.status(now.Add(maxLatency))
+	s.Equal(uint64(1), count)
+	s.Equal(uint64(21000), gas)
+	s.Equal(now.Add(time.Second), *oldestAt)
+	s.False(backedOff)
+	s.Equal(uint64(1), s.p.pendingTxsTracker.removedCount())
+	triggered, _ = s.p.shouldTriggerProposing(now.Add(maxLatency))
+	s.False(triggered)
+
+	// The transactions which have left the pool are removed.
+	s.p.pendingTxsTracker.retain(rpc.PoolContent{})
+	count, _, _, _ = s.p.pendingTxsTracker.status(now)
+	s.Zero(count)
+	s.Equal(uint64(1), s.p.pendingTxsTracker.removedCount())
+	triggered, _ = s.p.shouldTriggerProposing(now.Add(maxLatency + time.Second))
+	s.False(triggered)
+}
+
+func (s *ProposerTestSuite) TestL1SlotWindowDelay() {
+	var (
+		lower      = 2 * time.Second
+		upper      = 4 * time.Second
+		l1HeadTime = uint64(time.Now().Unix())
+		l1Head     = time.Unix(int64(l1HeadTime), 0)
+	)
+
+	// No window configured.
+	s.Zero(s.p.l1SlotWindowDelay(l1HeadTime, l1Head))
+
+	s.p.l1SlotWindowLowerBound = &lower
+	s.p.l1SlotWindowUpperBound = &upper
+	defer func() {
+		s.p.l1SlotWindowLowerBound = nil
+		s.p.l1SlotWindowUpperBound = nil
+	}()
+
+	s.Equal(2*time.Second, s.p.l1SlotWindowDelay(l1HeadTime, l1Head))
+	s.Zero(s.p.l1SlotWindowDelay(l1HeadTime, l1Head.Add(3*time.Second)))
+	s.Equal(9*time.Second, s.p.l1SlotWindowDelay(l1HeadTime, l1Head.Add(5*time.Second)))
+	s.Equal(time.Second, s.p.l1SlotWindowDelay(l1HeadTime, l1Head.Add(l1SlotDuration+time.Second)))
+}
@@ -0,0 +1,220 @@
+package proposer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	gethRPC "github.com/ethereum/go-ethereum/rpc"
+	"github.com/taikoxyz/taiko-client/metrics"
+	"github.com/taikoxyz/taiko-client/pkg/rpc"
+	rpcErrors "github.com/taikoxyz/taiko-client/pkg/rpc_errors"
+)
+
+// errStaleNonce is returned when a simulated transaction's nonce has already been used.
+var errStaleNonce = errors.New("nonce too low")
+
+// l2StateReader reads the L2 accounts state and executes the calls which are used to simulate the
+// transactions lists, it is implemented by `rpc.L2Client`, and can be replaced by a local stand-in
+// engine in tests.
+type l2StateReader interface {
+	NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error)
+	BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error)
+	CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error
+}
+
+// accountOverride is the state override of an account in an `eth_call` request.
+type accountOverride struct {
+	Nonce   hexutil.Uint64 `json:"nonce"`
+	Balance *hexutil.Big   `json:"balance"`
+}
+
+// simulatedAccount represents an account's state after applying all simulated transactions.
+type simulatedAccount struct {
+	nonce   uint64
+	balance *big.Int
+	skipped bool
+}
+
+// txListSimulator simulates the candidate transactions lists against the current L2 head's state,
+// and drops the transactions which would be skipped by the L2 execution engine, because of
+// invalid nonces or insufficient balances, or which would fail, e.g. revert or run out of gas.
+// Each transaction is executed by an `eth_call` on top of the L2 head, with the nonces and balances
+// of the accounts changed by the earlier transactions carried over as state overrides.
+// NOTE: the storage changes of the earlier transactions are not carried over, and the balances are
+// charged with the transactions' maximum costs, so the transactions depending on the earlier ones in
+// the same lists might be dropped, or kept even though they will fail.
+type txListSimulator struct {
+	stateReader l2StateReader
+	signer      types.Signer
+}
+
+// newTxListSimulator creates a new transactions lists simulator instance.
+func newTxListSimulator(stateReader l2StateReader, chainID *big.Int) *txListSimulator {
+	return &txListSimulator{
+		stateReader: stateReader,
+		signer:      types.LatestSignerForChainID(chainID),
+	}
+}
+
+// simulate applies the given transactions lists in order, and returns all transactions which can be
+// executed successfully, grouped by their senders.
+func (s *txListSimulator) simulate(ctx context.Context, txLists [][]*types.Transaction) (rpc.PoolContent, error) {
+	var (
+		accounts = make(map[common.Address]*simulatedAccount)
+		executed = make(rpc.PoolContent)
+	)
+
+	for _, txs := range txLists {
+		for _, tx := range txs {
+			sender, err := types.Sender(s.signer, tx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get transaction %s sender: %w", tx.Hash(), err)
+			}
+
+			account, ok := accounts[sender]
+			if !ok {
+				if account, err = s.loadAccount(ctx, sender); err != nil {
+					return nil, err
+				}
+				accounts[sender] = account
+			}
+
+			// If a transaction is dropped because of a nonce gap, an insufficient balance or a failed
+			// execution, all this sender's other transactions with larger nonce will be skipped too.
+			if account.skipped {
+				metrics.ProposerSimulationDroppedTxsCounter.Inc(1)
+				continue
+			}
+
+			reason := account.check(tx)
+			if reason == nil {
+				if reason, err = s.execute(ctx, sender, tx, accounts); err != nil {
+					return nil, err
+				}
+			}
+			if reason != nil {
+				log.Debug("Drop transaction after simulation", "hash", tx.Hash(), "sender", sender, "reason", reason)
+				metrics.ProposerSimulationDroppedTxsCounter.Inc(1)
+				// A stale transaction is skipped alone by the L2 execution engine, the sender's
+				// following transactions can still be executed, while the other dropped ones
+				// leave nonce gaps.
+				if !errors.Is(reason, errStaleNonce) {
+					account.skipped = true
+				}
+				continue
+			}
+
+			account.apply(tx)
+			// Credit the transferred ether to the recipient, if its state is being simulated.
+			if to := tx.To(); to != nil {
+				if recipient, ok := accounts[*to]; ok {
+					recipient.balance.Add(recipient.balance, tx.Value())
+				}
+			}
+
+			if _, ok := executed[sender]; !ok {
+				executed[sender] = make(map[string]*types.Transaction)
+			}
+			executed[sender][strconv.FormatUint(tx.Nonce(), 10)] = tx
+		}
+	}
+
+	return executed, nil
+}
+
+// loadAccount fetches the given account's state at the current L2 head.
+func (s *txListSimulator) loadAccount(ctx context.Context, account common.Address) (*simulatedAccount, error) {
+	nonce, err := s.stateReader.NonceAt(ctx, account, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get account %s nonce: %w", account, err)
+	}
+
+	balance, err := s.stateReader.BalanceAt(ctx, account, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get account %s balance: %w", account, err)
+	}
+
+	return &simulatedAccount{nonce: nonce, balance: new(big.Int).Set(balance)}, nil
+}
+
+// execute executes the given transaction by an `eth_call` on top of the L2 head, with the simulated
+// accounts' states as overrides, returns the failure reason if the execution fails.
+func (s *txListSimulator) execute(
+	ctx context.Context,
+	sender common.Address,
+	tx *types.Transaction,
+	accounts map[common.Address]*simulatedAccount,
+) (reason error, err error) {
+	overrides := make(map[common.Address]*accountOverride, len(accounts))
+	for address, account := range accounts {
+		overrides[address] = &accountOverride{
+			Nonce:   hexutil.Uint64(account.nonce),
+			Balance: (*hexutil.Big)(new(big.Int).Set(account.balance)),
+		}
+	}
+
+	var result hexutil.Bytes
+	if err := s.stateReader.CallContext(ctx, &result, "eth_call", toCallArg(sender, tx), "latest", overrides); err != nil {
+		// The L2 execution engine has responded with a JSON-RPC error, i.e. the execution failed.
+		var rpcErr gethRPC.Error
+		if errors.As(err, &rpcErr) && !errors.Is(rpcErrors.Decode(err), rpcErrors.ErrMethodNotFound) {
+			return fmt.Errorf("execution failed: %w", err), nil
+		}
+		return nil, fmt.Errorf("failed to simulate transaction %s: %w", tx.Hash(), err)
+	}
+
+	return nil, nil
+}
+
+// toCallArg converts the given transaction to the arguments of an `eth_call` request.
+func toCallArg(sender common.Address, tx *types.Transaction) map[string]interface{} {
+	arg := map[string]interface{}{
+		"from":  sender,
+		"to":    tx.To(),
+		"gas":   hexutil.Uint64(tx.Gas()),
+		"value": (*hexutil.Big)(tx.Value()),
+		"input": hexutil.Bytes(tx.Data()),
+	}
+
+	if tx.Type() == types.LegacyTxType {
+		arg["gasPrice"] = (*hexutil.Big)(tx.GasPrice())
+	} else {
+		arg["maxFeePerGas"] = (*hexutil.Big)(tx.GasFeeCap())
+		arg["maxPriorityFeePerGas"] = (*hexutil.Big)(tx.GasTipCap())
+		arg["accessList"] = tx.AccessList()
+	}
+
+	return arg
+}
+
+// check checks whether the given transaction can be applied on top of current account state, i.e.
+// it has the expected nonce, and the account can afford it.
+func (a *simulatedAccount) check(tx *types.Transaction) error {
+	if tx.Nonce() < a.nonce {
+		return fmt.Errorf("%w, got=%d, want=%d", errStaleNonce, tx.Nonce(), a.nonce)
+	}
+	if tx.Nonce() > a.nonce {
+		return fmt.Errorf("nonce gap, got=%d, want=%d", tx.Nonce(), a.nonce)
+	}
+
+	// Upper bound of the transaction's cost, same as the balance check in L2 execution engine.
+	if cost := tx.Cost(); a.balance.Cmp(cost) < 0 {
+		return fmt.Errorf("insufficient balance, got=%s, want=%s", a.balance, cost)
+	}
+
+	return nil
+}
+
+// apply updates the account state after the given transaction is executed, which is charged with
+// its maximum cost.
+func (a *simulatedAccount) apply(tx *types.Transaction) {
+	a.nonce++
+	a.balance.Sub(a.balance, tx.Cost())
+}
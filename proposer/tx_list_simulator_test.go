@@ -0,0 +1,146 @@
+package proposer
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/taikoxyz/taiko-client/testutils"
+)
+
+// standInL2Engine is a local stand-in of the L2 execution engine, which serves accounts state
+// from memory, and reverts all calls to the given contracts.
+type standInL2Engine struct {
+	nonces    map[common.Address]uint64
+	balances  map[common.Address]*big.Int
+	reverting map[common.Address]bool
+	// State overrides of the calls, by the calls' senders and nonces.
+	overrides map[common.Address]map[uint64]map[common.Address]*accountOverride
+}
+
+// testRevertError is a JSON-RPC revert error.
+type testRevertError struct{}
+
+func (e *testRevertError) Error() string  { return "execution reverted" }
+func (e *testRevertError) ErrorCode() int { return 3 }
+
+// NonceAt implements the l2StateReader interface.
+func (e *standInL2Engine) NonceAt(ctx context.Context, account common.Address, _ *big.Int) (uint64, error) {
+	return e.nonces[account], nil
+}
+
+// BalanceAt implements the l2StateReader interface.
+func (e *standInL2Engine) BalanceAt(ctx context.Context, account common.Address, _ *big.Int) (*big.Int, error) {
+	if balance, ok := e.balances[account]; ok {
+		return balance, nil
+	}
+	return common.Big0, nil
+}
+
+// CallContext implements the l2StateReader interface.
+func (e *standInL2Engine) CallContext(
+	ctx context.Context,
+	result interface{},
+	method string,
+	args ...interface{},
+) error {
+	if method != "eth_call" {
+		return fmt.Errorf("unexpected method: %s", method)
+	}
+
+	var (
+		arg       = args[0].(map[string]interface{})
+		sender    = arg["from"].(common.Address)
+		overrides = args[2].(map[common.Address]*accountOverride)
+	)
+	if _, ok := e.overrides[sender]; !ok {
+		e.overrides[sender] = make(map[uint64]map[common.Address]*accountOverride)
+	}
+	e.overrides[sender][uint64(overrides[sender].Nonce)] = overrides
+
+	if to := arg["to"].(*common.Address); to != nil && e.reverting[*to] {
+		return &testRevertError{}
+	}
+
+	return nil
+}
+
+func (s *ProposerTestSuite) TestTxListSimulatorSimulate() {
+	chainID := common.Big1
+	signer := types.LatestSignerForChainID(chainID)
+
+	privKeyA, err := crypto.GenerateKey()
+	s.Nil(err)
+	privKeyB, err := crypto.GenerateKey()
+	s.Nil(err)
+	privKeyC, err := crypto.GenerateKey()
+	s.Nil(err)
+
+	addrA := crypto.PubkeyToAddress(privKeyA.PublicKey)
+	addrB := crypto.PubkeyToAddress(privKeyB.PublicKey)
+	addrC := crypto.PubkeyToAddress(privKeyC.PublicKey)
+	revertingContract := common.BytesToAddress(testutils.RandomBytes(20))
+
+	// Each transaction costs 21000 * 1 + 1 = 21001 wei.
+	newTxTo := func(nonce uint64, privKey *ecdsa.PrivateKey, to common.Address) *types.Transaction {
+		tx, err := types.SignTx(
+			types.NewTransaction(nonce, to, common.Big1, 21000, common.Big1, []byte{}),
+			signer,
+			privKey,
+		)
+		s.Nil(err)
+		return tx
+	}
+	newTx := func(nonce uint64, privKey *ecdsa.PrivateKey) *types.Transaction {
+		return newTxTo(nonce, privKey, common.Address{})
+	}
+
+	engine := &standInL2Engine{
+		nonces: map[common.Address]uint64{addrA: 1, addrB: 0, addrC: 0},
+		balances: map[common.Address]*big.Int{
+			addrA: big.NewInt(21001 * 10),
+			addrB: big.NewInt(21001 * 2),
+			addrC: big.NewInt(21001 * 10),
+		},
+		reverting: map[common.Address]bool{revertingContract: true},
+		overrides: make(map[common.Address]map[uint64]map[common.Address]*accountOverride),
+	}
+
+	simulator := newTxListSimulator(engine, chainID)
+
+	executed, err := simulator.simulate(context.Background(), [][]*types.Transaction{
+		{
+			newTx(0, privKeyA), // Nonce too low, only this one is dropped
+			newTx(1, privKeyA),
+			newTx(0, privKeyB),
+			newTx(1, privKeyB),
+		},
+		{
+			newTx(2, privKeyB),                      // Insufficient balance
+			newTx(3, privKeyB),                      // Skipped, since the previous one is dropped
+			newTx(3, privKeyA),                      // Nonce gap
+			newTx(2, privKeyA),                      // Skipped, since the previous one is dropped
+			newTxTo(0, privKeyC, revertingContract), // Reverted
+			newTx(1, privKeyC),                      // Skipped, since the previous one is dropped
+		},
+	})
+	s.Nil(err)
+
+	s.Equal(1, len(executed[addrA]))
+	s.Equal(uint64(1), executed[addrA]["1"].Nonce())
+	s.Equal(2, len(executed[addrB]))
+	s.Equal(uint64(0), executed[addrB]["0"].Nonce())
+	s.Equal(uint64(1), executed[addrB]["1"].Nonce())
+	s.Empty(executed[addrC])
+
+	// The states changed by the earlier transactions should be carried over.
+	overrides := engine.overrides[addrB][1]
+	s.Equal(uint64(1), uint64(overrides[addrB].Nonce))
+	s.Equal(big.NewInt(21001), overrides[addrB].Balance.ToInt())
+	s.Equal(uint64(2), uint64(overrides[addrA].Nonce))
+	s.Equal(big.NewInt(21001*9), overrides[addrA].Balance.ToInt())
+}
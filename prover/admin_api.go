@@ -0,0 +1,180 @@
+package prover
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+	gethRPC "github.com/ethereum/go-ethereum/rpc"
+)
+
+// adminAPINamespace is the JSON-RPC namespace of the prover admin API.
+const adminAPINamespace = "prover"
+
+// adminStatus represents the current cursors and intake status of the prover.
+type adminStatus struct {
+	L1Current              uint64 `json:"l1Current"`
+	LastHandledBlockID     uint64 `json:"lastHandledBlockID"`
+	LatestVerifiedL1Height uint64 `json:"latestVerifiedL1Height"`
+	IntakePaused           bool   `json:"intakePaused"`
+	InFlightProofRequests  int    `json:"inFlightProofRequests"`
+}
+
+// adminJob represents a proving job returned by the admin API.
+type adminJob struct {
+	BlockID      uint64           `json:"blockID"`
+	Status       provingJobStatus `json:"status"`
+	L1Height     uint64           `json:"l1Height"`
+	IsValidProof bool             `json:"isValidProof"`
+	Backend      string           `json:"backend,omitempty"`
+	StartedAt    *time.Time       `json:"startedAt,omitempty"`
+	UpdatedAt    time.Time        `json:"updatedAt"`
+	LastError    string           `json:"lastError,omitempty"`
+}
+
+// adminAPI provides the JSON-RPC methods to inspect and control the proving jobs.
+type adminAPI struct {
+	p *Prover
+}
+
+// Status returns the current cursors and intake status of the prover.
+func (api *adminAPI) Status() *adminStatus {
+	api.p.cursorMutex.Lock()
+	status := &adminStatus{
+		L1Current:              api.p.l1Current,
+		LastHandledBlockID:     api.p.lastHandledBlockID,
+		LatestVerifiedL1Height: api.p.latestVerifiedL1Height,
+		IntakePaused:           api.p.intakePaused,
+	}
+	api.p.cursorMutex.Unlock()
+
+	api.p.proofRequestsMutex.Lock()
+	status.InFlightProofRequests = len(api.p.proofRequests)
+	api.p.proofRequestsMutex.Unlock()
+
+	return status
+}
+
+// Jobs returns all proving jobs ordered by block ID, or only the jobs with the given status
+// if there is one.
+func (api *adminAPI) Jobs(status *string) []*adminJob {
+	jobs := make([]*adminJob, 0)
+	for _, job := range api.p.provingJobs.list() {
+		if status != nil && string(job.Status) != *status {
+			continue
+		}
+
+		item := &adminJob{
+			BlockID:      job.BlockID,
+			Status:       job.Status,
+			L1Height:     job.L1Height,
+			IsValidProof: job.IsValidProof,
+			UpdatedAt:    job.UpdatedAt,
+			LastError:    job.LastError,
+		}
+		if !job.StartedAt.IsZero() {
+			startedAt := job.StartedAt
+			item.StartedAt = &startedAt
+		}
+
+		api.p.proofRequestsMutex.Lock()
+		if handle, ok := api.p.proofRequests[job.BlockID]; ok {
+			item.Backend = handle.Backend()
+		}
+		api.p.proofRequestsMutex.Unlock()
+
+		jobs = append(jobs, item)
+	}
+
+	return jobs
+}
+
+// EnqueueBlock schedules a proving task for the given block, even if the block has already been
+// handled by the prover.
+func (api *adminAPI) EnqueueBlock(ctx context.Context, blockID uint64) error {
+	if blockID == 0 {
+		return errors.New("invalid block ID: 0")
+	}
+
+	events, err := api.p.getBlockProposedEvents(ctx, blockID, blockID)
+	if err != nil {
+		return err
+	}
+
+	event, ok := events[blockID]
+	if !ok {
+		return fmt.Errorf("BlockProposed event of block %d not found", blockID)
+	}
+
+	if !api.p.provingScheduler.push(event) {
+		return fmt.Errorf("block %d has already been scheduled", blockID)
+	}
+
+	log.Info("Block enqueued by admin", "blockID", blockID)
+
+	return nil
+}
+
+// CancelJob cancels the in-flight proof request of the given block.
+func (api *adminAPI) CancelJob(blockID uint64) error {
+	cancelled := api.p.cancelProofRequests(func(id *big.Int) bool {
+		return id.Uint64() == blockID
+	}, "cancelled by admin", false)
+
+	if cancelled == 0 {
+		return fmt.Errorf("no in-flight proof request of block %d", blockID)
+	}
+
+	return nil
+}
+
+// PauseIntake stops scheduling new proposed blocks, the scheduled ones will still be proven.
+func (api *adminAPI) PauseIntake() {
+	api.p.setIntakePaused(true)
+	log.Info("Proving intake paused by admin")
+}
+
+// ResumeIntake resumes scheduling new proposed blocks.
+func (api *adminAPI) ResumeIntake() {
+	api.p.setIntakePaused(false)
+	log.Info("Proving intake resumed by admin")
+}
+
+// serveAdminAPI starts the admin JSON-RPC API server on the configured address, the server
+// will be closed when prover's context is done.
+func (p *Prover) serveAdminAPI() error {
+	handler := gethRPC.NewServer()
+	if err := handler.RegisterName(adminAPINamespace, &adminAPI{p: p}); err != nil {
+		return fmt.Errorf("failed to register admin API: %w", err)
+	}
+
+	listener, err := net.Listen("tcp", p.cfg.AdminAPIAddr)
+	if err != nil {
+		return fmt.Errorf("failed to listen on admin API address: %w", err)
+	}
+
+	server := &http.Server{Handler: handler, ReadHeaderTimeout: 10 * time.Second}
+
+	go func() {
+		<-p.ctx.Done()
+		handler.Stop()
+		if err := server.Close(); err != nil {
+			log.Error("Failed to close admin API server", "error", err)
+		}
+	}()
+
+	go func() {
+		if err := server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Error("Admin API server error", "error", err)
+		}
+	}()
+
+	log.Info("Starting admin API server", "address", listener.Addr())
+
+	return nil
+}
@@ -0,0 +1,99 @@
+package prover
+
+import (
+	"context"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	gethRPC "github.com/ethereum/go-ethereum/rpc"
+	"github.com/taikoxyz/taiko-client/bindings"
+	"github.com/taikoxyz/taiko-client/prover/producer"
+	"github.com/taikoxyz/taiko-client/testutils"
+)
+
+func (s *ProverTestSuite) TestAdminAPI() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	provingJobs, err := newProvingJobStore("")
+	s.Nil(err)
+
+	p := &Prover{
+		ctx:                    ctx,
+		l1Current:              100,
+		lastHandledBlockID:     2,
+		latestVerifiedL1Height: 90,
+		provingJobs:            provingJobs,
+		proofRequests:          make(map[uint64]*producer.ProofRequestHandle),
+	}
+	p.provingScheduler = newProvingScheduler(
+		1,
+		func(*bindings.TaikoL1ClientBlockProposed) {},
+		func() uint64 {
+			_, lastHandledBlockID := p.cursor()
+			return lastHandledBlockID
+		},
+		p.onProvingCursorAdvanced,
+	)
+
+	handler := gethRPC.NewServer()
+	s.Nil(handler.RegisterName(adminAPINamespace, &adminAPI{p: p}))
+	client := gethRPC.DialInProc(handler)
+	defer client.Close()
+
+	// Status.
+	var status adminStatus
+	s.Nil(client.Call(&status, "prover_status"))
+	s.Equal(adminStatus{L1Current: 100, LastHandledBlockID: 2, LatestVerifiedL1Height: 90}, status)
+
+	// Pause and resume the intake.
+	s.Nil(client.Call(nil, "prover_pauseIntake"))
+	s.True(p.isIntakePaused())
+	s.Nil(client.Call(nil, "prover_resumeIntake"))
+	s.False(p.isIntakePaused())
+
+	// Jobs.
+	s.Nil(provingJobs.enqueue(3, 101, testutils.RandomHash()))
+	s.Nil(provingJobs.enqueue(4, 102, testutils.RandomHash()))
+	s.Nil(provingJobs.setStatus(4, provingJobProving))
+
+	lower, upper := time.Hour, 2*time.Hour
+	dummyProducer := &producer.DummyProofProducer{
+		RandomDummyProofDelayLowerBound: &lower,
+		RandomDummyProofDelayUpperBound: &upper,
+	}
+	handle, err := dummyProducer.RequestProof(
+		ctx,
+		&producer.ProofRequestOptions{},
+		common.Big3,
+		&bindings.LibDataBlockMetadata{},
+		&types.Header{Number: common.Big3},
+		make(chan *producer.ProofWithHeader),
+	)
+	s.Nil(err)
+	p.trackProofRequest(handle)
+
+	var jobs []*adminJob
+	s.Nil(client.Call(&jobs, "prover_jobs"))
+	s.Len(jobs, 2)
+	s.Equal(uint64(3), jobs[0].BlockID)
+	s.Equal("dummy", jobs[0].Backend)
+	s.Nil(jobs[0].StartedAt)
+	s.NotNil(jobs[1].StartedAt)
+
+	s.Nil(client.Call(&jobs, "prover_jobs", provingJobProving))
+	s.Len(jobs, 1)
+	s.Equal(uint64(4), jobs[0].BlockID)
+
+	s.Nil(client.Call(&status, "prover_status"))
+	s.Equal(1, status.InFlightProofRequests)
+
+	// Cancel jobs.
+	s.Nil(client.Call(nil, "prover_cancelJob", 3))
+	s.True(handle.Cancelled())
+	s.NotNil(client.Call(nil, "prover_cancelJob", 3))
+
+	// Enqueue invalid blocks.
+	s.NotNil(client.Call(nil, "prover_enqueueBlock", 0))
+}
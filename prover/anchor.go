@@ -3,14 +3,28 @@ package prover
 import (
 	"context"
 	"fmt"
+	"math/big"
 
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/taikoxyz/taiko-client/bindings"
 	"github.com/taikoxyz/taiko-client/bindings/encoding"
 )
 
-// validateAnchorTx checks whether the given transaction is a valid `TaikoL2.anchor` transaction.
-func (p *Prover) validateAnchorTx(ctx context.Context, tx *types.Transaction) error {
+// The R values of the signatures signed with K = 1 and K = 2, anchor transactions are always
+// signed with one of these two fixed K values.
+// ref: https://github.com/taikoxyz/taiko-mono/blob/main/packages/protocol/contracts/libs/LibAnchorSignature.sol
+var anchorTxSignatureRs = []*big.Int{fixedKSignatureR(1), fixedKSignatureR(2)}
+
+// validateAnchorTx checks whether the given transaction is a valid `TaikoL2.anchor` transaction
+// of the block with the given metadata.
+func (p *Prover) validateAnchorTx(
+	ctx context.Context,
+	tx *types.Transaction,
+	meta *bindings.LibDataBlockMetadata,
+) error {
 	if tx.To() == nil || *tx.To() != p.cfg.TaikoL2Address {
 		return fmt.Errorf("invalid TaikoL2.anchor transaction to: %s, want: %s", tx.To(), p.cfg.TaikoL2Address)
 	}
@@ -29,7 +43,49 @@ func (p *Prover) validateAnchorTx(ctx context.Context, tx *types.Transaction) er
 		return fmt.Errorf("invalid TaikoL2.anchor transaction selector, err: %w", err)
 	}
 
-	return nil
+	// Check the arguments against the block metadata.
+	args, err := method.Inputs.Unpack(tx.Data()[4:])
+	if err != nil || len(args) != 2 {
+		return fmt.Errorf("invalid TaikoL2.anchor transaction arguments, err: %w", err)
+	}
+
+	l1Height, ok := args[0].(*big.Int)
+	if !ok || meta.L1Height == nil || l1Height.Cmp(meta.L1Height) != 0 {
+		return fmt.Errorf("invalid TaikoL2.anchor transaction l1Height: %v, want: %v", args[0], meta.L1Height)
+	}
+
+	l1Hash, ok := args[1].([32]byte)
+	if !ok || common.Hash(l1Hash) != meta.L1Hash {
+		return fmt.Errorf("invalid TaikoL2.anchor transaction l1Hash: %v, want: %s", args[1], common.Hash(meta.L1Hash))
+	}
+
+	if tx.Gas() != p.protocolConstants.AnchorTxGasLimit.Uint64() {
+		return fmt.Errorf(
+			"invalid TaikoL2.anchor transaction gas limit: %d, want: %d",
+			tx.Gas(),
+			p.protocolConstants.AnchorTxGasLimit,
+		)
+	}
+
+	// Check whether the transaction is signed with a fixed K value.
+	_, r, _ := tx.RawSignatureValues()
+	for _, anchorTxSignatureR := range anchorTxSignatureRs {
+		if r.Cmp(anchorTxSignatureR) == 0 {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("invalid TaikoL2.anchor transaction signature R: %s, not signed with a fixed K", r)
+}
+
+// fixedKSignatureR calculates the R value of the ECDSA signatures signed with the given fixed K.
+func fixedKSignatureR(k uint32) *big.Int {
+	var kG secp256k1.JacobianPoint
+	secp256k1.ScalarBaseMultNonConst(new(secp256k1.ModNScalar).SetInt(k), &kG)
+	kG.ToAffine()
+
+	x := kG.X.Bytes()
+	return new(big.Int).Mod(new(big.Int).SetBytes(x[:]), crypto.S256().Params().N)
 }
 
 // getAndValidateAnchorTxReceipt gets and validates the `TaikoL2.anchor` transaction's receipt.
@@ -4,10 +4,14 @@ import (
 	"context"
 	"math/rand"
 
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/taikoxyz/taiko-client/bindings"
+	"github.com/taikoxyz/taiko-client/bindings/encoding"
+	driverSigner "github.com/taikoxyz/taiko-client/driver/signer"
+	"github.com/taikoxyz/taiko-client/testutils"
 )
 
 func (s *ProverTestSuite) TestValidateAnchorTx() {
@@ -18,9 +22,11 @@ func (s *ProverTestSuite) TestValidateAnchorTx() {
 	goldenTouchPriKey, err := crypto.HexToECDSA(bindings.GoldenTouchPrivKey[2:])
 	s.Nil(err)
 
+	meta := &bindings.LibDataBlockMetadata{L1Height: common.Big1, L1Hash: testutils.RandomHash()}
+
 	// invalid To
 	tx := types.NewTransaction(0, common.BytesToAddress(randBytes(1024)), common.Big0, 0, common.Big0, []byte{})
-	s.ErrorContains(s.p.validateAnchorTx(context.Background(), tx), "invalid TaikoL2.anchor transaction to")
+	s.ErrorContains(s.p.validateAnchorTx(context.Background(), tx, meta), "invalid TaikoL2.anchor transaction to")
 
 	// invalid sender
 	dynamicFeeTxTx := &types.DynamicFeeTx{
@@ -39,12 +45,51 @@ func (s *ProverTestSuite) TestValidateAnchorTx() {
 	tx = types.MustSignNewTx(wrongPrivKey, signer, dynamicFeeTxTx)
 
 	s.ErrorContains(
-		s.p.validateAnchorTx(context.Background(), tx), "invalid TaikoL2.anchor transaction sender",
+		s.p.validateAnchorTx(context.Background(), tx, meta), "invalid TaikoL2.anchor transaction sender",
 	)
 
 	// invalid method selector
 	tx = types.MustSignNewTx(goldenTouchPriKey, signer, dynamicFeeTxTx)
-	s.ErrorContains(s.p.validateAnchorTx(context.Background(), tx), "invalid TaikoL2.anchor transaction selector")
+	s.ErrorContains(
+		s.p.validateAnchorTx(context.Background(), tx, meta), "invalid TaikoL2.anchor transaction selector",
+	)
+
+	// invalid arguments
+	dynamicFeeTxTx.Data, err = encoding.TaikoL2ABI.Pack("anchor", common.Big2, meta.L1Hash)
+	s.Nil(err)
+	tx = types.MustSignNewTx(goldenTouchPriKey, signer, dynamicFeeTxTx)
+	s.ErrorContains(s.p.validateAnchorTx(context.Background(), tx, meta), "invalid TaikoL2.anchor transaction l1Height")
+
+	dynamicFeeTxTx.Data, err = encoding.TaikoL2ABI.Pack("anchor", meta.L1Height, testutils.RandomHash())
+	s.Nil(err)
+	tx = types.MustSignNewTx(goldenTouchPriKey, signer, dynamicFeeTxTx)
+	s.ErrorContains(s.p.validateAnchorTx(context.Background(), tx, meta), "invalid TaikoL2.anchor transaction l1Hash")
+
+	// invalid gas limit
+	dynamicFeeTxTx.Data, err = encoding.TaikoL2ABI.Pack("anchor", meta.L1Height, meta.L1Hash)
+	s.Nil(err)
+	tx = types.MustSignNewTx(goldenTouchPriKey, signer, dynamicFeeTxTx)
+	s.ErrorContains(
+		s.p.validateAnchorTx(context.Background(), tx, meta), "invalid TaikoL2.anchor transaction gas limit",
+	)
+
+	// not signed with a fixed K
+	dynamicFeeTxTx.Gas = s.p.protocolConstants.AnchorTxGasLimit.Uint64()
+	tx = types.MustSignNewTx(goldenTouchPriKey, signer, dynamicFeeTxTx)
+	s.ErrorContains(
+		s.p.validateAnchorTx(context.Background(), tx, meta), "invalid TaikoL2.anchor transaction signature R",
+	)
+
+	// valid
+	fixedKSigner, err := driverSigner.NewFixedKSigner(bindings.GoldenTouchPrivKey)
+	s.Nil(err)
+	sig, ok := fixedKSigner.SignWithK(new(secp256k1.ModNScalar).SetInt(1))(
+		signer.Hash(types.NewTx(dynamicFeeTxTx)).Bytes(),
+	)
+	s.True(ok)
+	tx, err = types.NewTx(dynamicFeeTxTx).WithSignature(signer, sig)
+	s.Nil(err)
+	s.Nil(s.p.validateAnchorTx(context.Background(), tx, meta))
 }
 
 func randBytes(l uint64) []byte {
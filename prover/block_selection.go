@@ -0,0 +1,45 @@
+package prover
+
+import (
+	"math/big"
+	"time"
+)
+
+// blockSelection represents the decision of the block selection policy on a proposed block.
+type blockSelection int
+
+// All block selection decisions.
+const (
+	// The block should be proven by current prover.
+	blockSelected blockSelection = iota
+	// The block should never be proven by current prover.
+	blockSkipped
+	// The block is not selectable yet, but might be selected later.
+	blockPostponed
+)
+
+// blockSelectionPolicy decides which proposed blocks current prover should prove, so that
+// a fleet of provers can split the work deterministically.
+type blockSelectionPolicy struct {
+	minAge     *time.Duration
+	shardIndex uint64
+	shardTotal uint64
+}
+
+// selectBlock decides whether the given proposed block should be proven by current prover,
+// and returns the reason if it should not.
+func (s *blockSelectionPolicy) selectBlock(
+	blockID *big.Int,
+	proposedAt uint64,
+	now time.Time,
+) (blockSelection, string) {
+	if s.shardTotal != 0 && new(big.Int).Mod(blockID, new(big.Int).SetUint64(s.shardTotal)).Uint64() != s.shardIndex {
+		return blockSkipped, "not in current shard"
+	}
+
+	if s.minAge != nil && now.Sub(time.Unix(int64(proposedAt), 0)) < *s.minAge {
+		return blockPostponed, "not old enough"
+	}
+
+	return blockSelected, ""
+}
@@ -0,0 +1,40 @@
+package prover
+
+import (
+	"math/big"
+	"time"
+)
+
+func (s *ProverTestSuite) TestSelectBlock() {
+	now := time.Now()
+	proposedAt := uint64(now.Add(-10 * time.Minute).Unix())
+
+	// Every block should be selected by default.
+	policy := &blockSelectionPolicy{}
+	selection, _ := policy.selectBlock(big.NewInt(1), proposedAt, now)
+	s.Equal(blockSelected, selection)
+
+	// Blocks not in current shard should be skipped.
+	policy = &blockSelectionPolicy{shardIndex: 1, shardTotal: 3}
+	for id := int64(0); id < 9; id++ {
+		selection, _ = policy.selectBlock(big.NewInt(id), proposedAt, now)
+		if id%3 == 1 {
+			s.Equal(blockSelected, selection)
+		} else {
+			s.Equal(blockSkipped, selection)
+		}
+	}
+
+	// Blocks not old enough should be postponed.
+	minAge := 5 * time.Minute
+	policy = &blockSelectionPolicy{minAge: &minAge}
+	selection, _ = policy.selectBlock(big.NewInt(1), proposedAt, now)
+	s.Equal(blockSelected, selection)
+	selection, _ = policy.selectBlock(big.NewInt(1), uint64(now.Add(-time.Minute).Unix()), now)
+	s.Equal(blockPostponed, selection)
+
+	// Shard check should take precedence over the age check.
+	policy = &blockSelectionPolicy{minAge: &minAge, shardIndex: 0, shardTotal: 2}
+	selection, _ = policy.selectBlock(big.NewInt(1), uint64(now.Unix()), now)
+	s.Equal(blockSkipped, selection)
+}
@@ -0,0 +1,167 @@
+package prover
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/taikoxyz/taiko-client/metrics"
+	"github.com/taikoxyz/taiko-client/pkg/rpc"
+	rpcErrors "github.com/taikoxyz/taiko-client/pkg/rpc_errors"
+)
+
+// verifiableBlocks represents the proven but unverified blocks, which can be verified
+// by sending a TaikoL1.verifyBlocks transaction.
+type verifiableBlocks struct {
+	count            uint64
+	oldestProposedAt uint64
+}
+
+// verifyBlocksLoop keeps checking the proven but unverified blocks, and tries to verify them.
+func (p *Prover) verifyBlocksLoop() {
+	ticker := time.NewTicker(p.cfg.VerifyBlocksInterval)
+	defer func() {
+		ticker.Stop()
+		p.wg.Done()
+	}()
+
+	for {
+		select {
+		case <-p.ctx.Done():
+			return
+		case <-ticker.C:
+			if err := p.verifyBlocksOp(p.ctx); err != nil {
+				log.Error("Verify blocks error", "error", err)
+			}
+		}
+	}
+}
+
+// verifyBlocksOp sends a TaikoL1.verifyBlocks transaction, if verifying the current proven
+// but unverified blocks is profitable or overdue.
+func (p *Prover) verifyBlocksOp(ctx context.Context) error {
+	verifiable, err := p.getVerifiableBlocks(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get verifiable blocks: %w", err)
+	}
+
+	metrics.ProverVerifiableBlocksGauge.Update(int64(verifiable.count))
+
+	if !p.shouldVerifyBlocks(verifiable, time.Now()) {
+		return nil
+	}
+
+	return p.sendVerifyBlocksTx(ctx, verifiable.count)
+}
+
+// getVerifiableBlocks counts the consecutive proven blocks after the latest verified block,
+// at most MaxVerificationsPerTx blocks will be counted.
+func (p *Prover) getVerifiableBlocks(ctx context.Context) (*verifiableBlocks, error) {
+	stateVars, err := p.rpc.GetProtocolStateVariables(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// The parent of the first unverified block is the latest verified block.
+	parentHash, err := p.rpc.TaikoL1.GetLatestSyncedHeader(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		verifiable = new(verifiableBlocks)
+		maxBlocks  = p.protocolConstants.MaxVerificationsPerTx.Uint64()
+	)
+	for id := stateVars.LatestVerifiedID + 1; id < stateVars.NextBlockID && verifiable.count < maxBlocks; id++ {
+		blockID := new(big.Int).SetUint64(id)
+
+		provers, err := p.rpc.TaikoL1.GetBlockProvers(nil, blockID, parentHash)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(provers) == 0 {
+			break
+		}
+
+		if verifiable.count == 0 {
+			proposedBlock, err := p.rpc.TaikoL1.GetProposedBlock(nil, blockID)
+			if err != nil {
+				return nil, err
+			}
+			verifiable.oldestProposedAt = proposedBlock.ProposedAt
+		}
+		verifiable.count++
+
+		// Use the block in local L2 execution engine as the parent of the next block.
+		l1Origin, err := p.rpc.L2.L1OriginByID(ctx, blockID)
+		if err != nil {
+			// The local L2 execution engine is behind.
+			if rpcErrors.IsNotFound(err) {
+				break
+			}
+			return nil, err
+		}
+		parentHash = l1Origin.L2BlockHash
+	}
+
+	return verifiable, nil
+}
+
+// shouldVerifyBlocks checks whether verifying the given blocks is profitable, i.e. there are enough
+// blocks to share the transaction cost, or overdue, i.e. the oldest block has waited too long.
+func (p *Prover) shouldVerifyBlocks(verifiable *verifiableBlocks, now time.Time) bool {
+	if verifiable.count == 0 {
+		return false
+	}
+
+	threshold := p.cfg.VerifyBlocksThreshold
+	if threshold == 0 || threshold > p.protocolConstants.MaxVerificationsPerTx.Uint64() {
+		threshold = p.protocolConstants.MaxVerificationsPerTx.Uint64()
+	}
+
+	if verifiable.count >= threshold {
+		return true
+	}
+
+	return now.Sub(time.Unix(int64(verifiable.oldestProposedAt), 0)) >= p.cfg.VerifyBlocksMaxDelay
+}
+
+// sendVerifyBlocksTx sends a TaikoL1.verifyBlocks transaction, the fee and nonce of the transaction
+// are handled in the same way as the proof submission transactions.
+func (p *Prover) sendVerifyBlocksTx(ctx context.Context, maxBlocks uint64) error {
+	txOpts, err := p.getProveBlocksTxOpts(ctx, p.rpc.L1)
+	if err != nil {
+		return err
+	}
+
+	sendTx := func() (*types.Transaction, error) {
+		p.submitProofTxMutex.Lock()
+		defer p.submitProofTxMutex.Unlock()
+
+		return p.rpc.TaikoL1.VerifyBlocks(txOpts, new(big.Int).SetUint64(maxBlocks))
+	}
+
+	tx, err := sendTx()
+	if err != nil {
+		// Protocol contract reverts returned by eth_estimateGas, e.g. the blocks have been verified by others.
+		if !rpcErrors.IsRetryable(err) {
+			log.Warn("Unretryable TaikoL1.verifyBlocks error", "maxBlocks", maxBlocks, "error", err)
+			return nil
+		}
+		return fmt.Errorf("failed to send TaikoL1.verifyBlocks transaction: %w", err)
+	}
+
+	if _, err := rpc.WaitReceipt(ctx, p.rpc.L1, tx); err != nil {
+		return fmt.Errorf("failed to wait till TaikoL1.verifyBlocks transaction executed: %w", err)
+	}
+
+	log.Info("🔏 Blocks verification sent", "maxBlocks", maxBlocks, "txHash", tx.Hash())
+
+	metrics.ProverVerifyBlocksSentCounter.Inc(1)
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package prover
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	gethRPC "github.com/ethereum/go-ethereum/rpc"
+	"github.com/taikoxyz/taiko-client/bindings"
+	"github.com/taikoxyz/taiko-client/pkg/rpc"
+	rpcErrors "github.com/taikoxyz/taiko-client/pkg/rpc_errors"
+)
+
+// testTaikoService is a taiko namespace JSON-RPC service, which has no L1 origins.
+type testTaikoService struct{}
+
+func (s *testTaikoService) L1OriginByID(blockID *hexutil.Big) (*rawdb.L1Origin, error) {
+	return nil, ethereum.NotFound
+}
+
+func (s *ProverTestSuite) TestShouldVerifyBlocks() {
+	now := time.Now()
+	p := &Prover{
+		cfg:               &Config{VerifyBlocksMaxDelay: 10 * time.Minute},
+		protocolConstants: &bindings.ProtocolConstants{MaxVerificationsPerTx: big.NewInt(4)},
+	}
+
+	// Nothing to verify.
+	s.False(p.shouldVerifyBlocks(&verifiableBlocks{}, now))
+
+	// The threshold defaults to the maximum verifications per transaction.
+	recent := uint64(now.Add(-time.Minute).Unix())
+	s.False(p.shouldVerifyBlocks(&verifiableBlocks{count: 3, oldestProposedAt: recent}, now))
+	s.True(p.shouldVerifyBlocks(&verifiableBlocks{count: 4, oldestProposedAt: recent}, now))
+
+	p.cfg.VerifyBlocksThreshold = 2
+	s.True(p.shouldVerifyBlocks(&verifiableBlocks{count: 2, oldestProposedAt: recent}, now))
+
+	// Overdue blocks should be verified, even if the threshold is not reached.
+	p.cfg.VerifyBlocksThreshold = 0
+	overdue := uint64(now.Add(-20 * time.Minute).Unix())
+	s.True(p.shouldVerifyBlocks(&verifiableBlocks{count: 1, oldestProposedAt: overdue}, now))
+}
+
+func (s *ProverTestSuite) TestL1OriginNotFound() {
+	server := gethRPC.NewServer()
+	defer server.Stop()
+	s.Nil(server.RegisterName("taiko", &testTaikoService{}))
+
+	client := rpc.NewL2Client(gethRPC.DialInProc(server), nil)
+	_, err := client.L1OriginByID(context.Background(), common.Big1)
+	s.NotNil(err)
+
+	// The L2 execution engine's not found error is not the ethereum.NotFound sentinel.
+	s.False(errors.Is(err, ethereum.NotFound))
+	s.True(rpcErrors.IsNotFound(err))
+}
@@ -4,6 +4,7 @@ import (
 	"crypto/ecdsa"
 	"fmt"
 	"math/big"
+	"strconv"
 	"strings"
 	"time"
 
@@ -16,17 +17,36 @@ import (
 // Config contains the configurations to initialize a Taiko prover.
 type Config struct {
 	L1Endpoint                      string
+	L1FallbackEndpoints             []string
+	L1HealthCheckInterval           time.Duration
+	L1MaxHeadLag                    uint64
+	L1RateLimit                     uint64
+	L1LogsRateLimit                 uint64
+	L2RateLimit                     uint64
+	L2LogsRateLimit                 uint64
 	L2Endpoint                      string
 	TaikoL1Address                  common.Address
 	TaikoL2Address                  common.Address
 	L1ProverPrivKey                 *ecdsa.PrivateKey
-	ZKEvmRpcdEndpoint               string
+	ZKEvmRpcdEndpoints              []string
 	ZkEvmRpcdParamsPath             string
+	ZkEvmRpcdProofTimeout           time.Duration
 	StartingBlockID                 *big.Int
 	MaxConcurrentProvingJobs        uint
 	Dummy                           bool
 	RandomDummyProofDelayLowerBound *time.Duration
 	RandomDummyProofDelayUpperBound *time.Duration
+	ProvingJobsStateFile            string
+	ProveBlocksMinAge               *time.Duration
+	ProveBlocksShardIndex           uint64
+	ProveBlocksShardTotal           uint64
+	VerifyBlocks                    bool
+	VerifyBlocksInterval            time.Duration
+	VerifyBlocksThreshold           uint64
+	VerifyBlocksMaxDelay            time.Duration
+	ProofArtifactsDir               string
+	AdminAPIAddr                    string
+	RederiveBlocks                  bool
 }
 
 // NewConfigFromCliContext creates a new config instance from command line flags.
@@ -67,6 +87,31 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 		}
 	}
 
+	var proveBlocksMinAge *time.Duration
+	if c.IsSet(flags.ProveBlocksMinAge.Name) {
+		minAge := c.Duration(flags.ProveBlocksMinAge.Name)
+		proveBlocksMinAge = &minAge
+	}
+
+	var proveBlocksShardIndex, proveBlocksShardTotal uint64
+	if c.IsSet(flags.ProveBlocksShard.Name) {
+		flagValue := c.String(flags.ProveBlocksShard.Name)
+		splitted := strings.Split(flagValue, "/")
+		if len(splitted) != 2 {
+			return nil, fmt.Errorf("invalid prove blocks shard value: %s", flagValue)
+		}
+
+		if proveBlocksShardIndex, err = strconv.ParseUint(splitted[0], 10, 64); err != nil {
+			return nil, fmt.Errorf("invalid prove blocks shard value: %s, err: %w", flagValue, err)
+		}
+		if proveBlocksShardTotal, err = strconv.ParseUint(splitted[1], 10, 64); err != nil {
+			return nil, fmt.Errorf("invalid prove blocks shard value: %s, err: %w", flagValue, err)
+		}
+		if proveBlocksShardIndex >= proveBlocksShardTotal {
+			return nil, fmt.Errorf("invalid prove blocks shard value (index >= total): %s", flagValue)
+		}
+	}
+
 	var startingBlockID *big.Int
 	if c.IsSet(flags.StartingBlockID.Name) {
 		startingBlockID = new(big.Int).SetUint64(c.Uint64(flags.StartingBlockID.Name))
@@ -74,16 +119,35 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 
 	return &Config{
 		L1Endpoint:                      c.String(flags.L1WSEndpoint.Name),
+		L1FallbackEndpoints:             c.StringSlice(flags.L1WSFallbackEndpoints.Name),
+		L1HealthCheckInterval:           c.Duration(flags.L1HealthCheckInterval.Name),
+		L1MaxHeadLag:                    c.Uint64(flags.L1MaxHeadLag.Name),
+		L1RateLimit:                     c.Uint64(flags.L1RateLimit.Name),
+		L1LogsRateLimit:                 c.Uint64(flags.L1LogsRateLimit.Name),
+		L2RateLimit:                     c.Uint64(flags.L2RateLimit.Name),
+		L2LogsRateLimit:                 c.Uint64(flags.L2LogsRateLimit.Name),
 		L2Endpoint:                      c.String(flags.L2WSEndpoint.Name),
 		TaikoL1Address:                  common.HexToAddress(c.String(flags.TaikoL1Address.Name)),
 		TaikoL2Address:                  common.HexToAddress(c.String(flags.TaikoL2Address.Name)),
 		L1ProverPrivKey:                 l1ProverPrivKey,
-		ZKEvmRpcdEndpoint:               c.String(flags.ZkEvmRpcdEndpoint.Name),
+		ZKEvmRpcdEndpoints:              c.StringSlice(flags.ZkEvmRpcdEndpoint.Name),
 		ZkEvmRpcdParamsPath:             c.String(flags.ZkEvmRpcdParamsPath.Name),
+		ZkEvmRpcdProofTimeout:           c.Duration(flags.ZkEvmRpcdProofTimeout.Name),
 		StartingBlockID:                 startingBlockID,
 		MaxConcurrentProvingJobs:        c.Uint(flags.MaxConcurrentProvingJobs.Name),
 		Dummy:                           c.Bool(flags.Dummy.Name),
 		RandomDummyProofDelayLowerBound: randomDummyProofDelayLowerBound,
 		RandomDummyProofDelayUpperBound: randomDummyProofDelayUpperBound,
+		ProvingJobsStateFile:            c.String(flags.ProvingJobsStateFile.Name),
+		ProveBlocksMinAge:               proveBlocksMinAge,
+		ProveBlocksShardIndex:           proveBlocksShardIndex,
+		ProveBlocksShardTotal:           proveBlocksShardTotal,
+		VerifyBlocks:                    c.Bool(flags.VerifyBlocks.Name),
+		VerifyBlocksInterval:            c.Duration(flags.VerifyBlocksInterval.Name),
+		VerifyBlocksThreshold:           c.Uint64(flags.VerifyBlocksThreshold.Name),
+		VerifyBlocksMaxDelay:            c.Duration(flags.VerifyBlocksMaxDelay.Name),
+		ProofArtifactsDir:               c.String(flags.ProofArtifactsDir.Name),
+		AdminAPIAddr:                    c.String(flags.AdminAPIAddr.Name),
+		RederiveBlocks:                  c.Bool(flags.RederiveBlocks.Name),
 	}, nil
 }
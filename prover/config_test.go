@@ -3,6 +3,7 @@ package prover
 import (
 	"context"
 	"os"
+	"path/filepath"
 	"time"
 
 	"github.com/ethereum/go-ethereum/crypto"
@@ -15,6 +16,8 @@ func (s *ProverTestSuite) TestNewConfigFromCliContext() {
 	l2Endpoint := os.Getenv("L2_EXECUTION_ENGINE_ENDPOINT")
 	taikoL1 := os.Getenv("TAIKO_L1_ADDRESS")
 	taikoL2 := os.Getenv("TAIKO_L2_ADDRESS")
+	provingJobsStateFile := filepath.Join(s.T().TempDir(), "provingJobs.json")
+	proofArtifactsDir := filepath.Join(s.T().TempDir(), "proofs")
 
 	app := cli.NewApp()
 	app.Flags = []cli.Flag{
@@ -25,6 +28,17 @@ func (s *ProverTestSuite) TestNewConfigFromCliContext() {
 		&cli.StringFlag{Name: flags.L1ProverPrivKey.Name},
 		&cli.BoolFlag{Name: flags.Dummy.Name},
 		&cli.StringFlag{Name: flags.RandomDummyProofDelay.Name},
+		&cli.StringFlag{Name: flags.ProvingJobsStateFile.Name},
+		&cli.DurationFlag{Name: flags.ProveBlocksMinAge.Name},
+		&cli.StringFlag{Name: flags.ProveBlocksShard.Name},
+		&cli.BoolFlag{Name: flags.VerifyBlocks.Name},
+		&cli.DurationFlag{Name: flags.VerifyBlocksInterval.Name},
+		&cli.Uint64Flag{Name: flags.VerifyBlocksThreshold.Name},
+		&cli.DurationFlag{Name: flags.VerifyBlocksMaxDelay.Name},
+		&cli.StringFlag{Name: flags.ProofArtifactsDir.Name},
+		&cli.StringFlag{Name: flags.AdminAPIAddr.Name},
+		&cli.BoolFlag{Name: flags.RederiveBlocks.Name},
+		&cli.DurationFlag{Name: flags.ZkEvmRpcdProofTimeout.Name},
 	}
 	app.Action = func(ctx *cli.Context) error {
 		c, err := NewConfigFromCliContext(ctx)
@@ -40,6 +54,18 @@ func (s *ProverTestSuite) TestNewConfigFromCliContext() {
 		s.Equal(30*time.Minute, *c.RandomDummyProofDelayLowerBound)
 		s.Equal(time.Hour, *c.RandomDummyProofDelayUpperBound)
 		s.True(c.Dummy)
+		s.Equal(provingJobsStateFile, c.ProvingJobsStateFile)
+		s.Equal(5*time.Minute, *c.ProveBlocksMinAge)
+		s.Equal(uint64(1), c.ProveBlocksShardIndex)
+		s.Equal(uint64(3), c.ProveBlocksShardTotal)
+		s.True(c.VerifyBlocks)
+		s.Equal(30*time.Second, c.VerifyBlocksInterval)
+		s.Equal(uint64(5), c.VerifyBlocksThreshold)
+		s.Equal(20*time.Minute, c.VerifyBlocksMaxDelay)
+		s.Equal(proofArtifactsDir, c.ProofArtifactsDir)
+		s.Equal("127.0.0.1:9877", c.AdminAPIAddr)
+		s.True(c.RederiveBlocks)
+		s.Equal(2*time.Hour, c.ZkEvmRpcdProofTimeout)
 		s.Nil(new(Prover).InitFromCli(context.Background(), ctx))
 
 		return err
@@ -54,5 +80,16 @@ func (s *ProverTestSuite) TestNewConfigFromCliContext() {
 		"-" + flags.L1ProverPrivKey.Name, os.Getenv("L1_PROVER_PRIVATE_KEY"),
 		"-" + flags.Dummy.Name,
 		"-" + flags.RandomDummyProofDelay.Name, "30m-1h",
+		"-" + flags.ProvingJobsStateFile.Name, provingJobsStateFile,
+		"-" + flags.ProveBlocksMinAge.Name, "5m",
+		"-" + flags.ProveBlocksShard.Name, "1/3",
+		"-" + flags.VerifyBlocks.Name,
+		"-" + flags.VerifyBlocksInterval.Name, "30s",
+		"-" + flags.VerifyBlocksThreshold.Name, "5",
+		"-" + flags.VerifyBlocksMaxDelay.Name, "20m",
+		"-" + flags.ProofArtifactsDir.Name, proofArtifactsDir,
+		"-" + flags.AdminAPIAddr.Name, "127.0.0.1:9877",
+		"-" + flags.RederiveBlocks.Name,
+		"-" + flags.ZkEvmRpcdProofTimeout.Name, "2h",
 	}))
 }
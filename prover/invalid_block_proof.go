@@ -3,6 +3,7 @@ package prover
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/cenkalti/backoff/v4"
 	"github.com/ethereum/go-ethereum/core/types"
@@ -35,20 +36,22 @@ func (p *Prover) proveBlockInvalid(
 
 	// Request proof.
 	proofOpts := &producer.ProofRequestOptions{
-		Height:     throwAwayBlock.Header().Number,
-		L2Endpoint: p.cfg.L2Endpoint,
-		Retry:      false,
-		Param:      p.cfg.ZkEvmRpcdParamsPath,
+		Height:      throwAwayBlock.Header().Number,
+		L2Endpoint:  p.cfg.L2Endpoint,
+		Retry:       false,
+		Param:       p.cfg.ZkEvmRpcdParamsPath,
+		OnRequested: p.onProofRequested,
 	}
 
-	if err := p.proofProducer.RequestProof(
-		proofOpts, event.Id, &event.Meta, throwAwayBlock.Header(), p.proveInvalidProofCh,
+	if _, err := p.proofProducer.RequestProof(
+		ctx, proofOpts, event.Id, &event.Meta, throwAwayBlock.Header(), p.proveInvalidProofCh,
 	); err != nil {
 		return err
 	}
 
 	metrics.ProverQueuedProofCounter.Inc(1)
 	metrics.ProverQueuedInvalidProofCounter.Inc(1)
+	metrics.ProverProposalToRequestTimer.UpdateSince(time.Unix(int64(event.Meta.Timestamp), 0))
 
 	return nil
 }
@@ -102,6 +105,11 @@ func (p *Prover) submitInvalidBlockProof(
 		return fmt.Errorf("receipt root mismatch, receiptRoot: %s, block.ReceiptHash: %s", receiptRoot, header.ReceiptHash)
 	}
 
+	// Verify the merkle proof locally, so that malformed evidence won't be submitted to L1.
+	if err := verifyTrieProofOfItem(header.ReceiptHash, receipts, 0, receiptProof); err != nil {
+		return fmt.Errorf("invalid receipt proof: %w", err)
+	}
+
 	// Assemble the TaikoL1.proveBlockInvalid transaction inputs.
 	proofs := [][]byte{}
 	for i := 0; i < int(p.protocolConstants.ZKProofsPerBlock.Uint64()); i++ {
@@ -136,13 +144,21 @@ func (p *Prover) submitInvalidBlockProof(
 		return err
 	}
 
+	// Save the proof with its evidence inputs, so that it can be re-sent later if the submission fails.
+	p.saveProofArtifact(newProofArtifact(blockID, header.Hash(), false, input, receiptProof))
+
+	// Make sure the block metadata in evidence is not outdated by an L1 reorg.
+	if err := p.checkProposedEventCanonical(ctx, blockID); err != nil {
+		return err
+	}
+
 	// Send the TaikoL1.proveBlockInvalid transaction.
 	txOpts, err := p.getProveBlocksTxOpts(ctx, p.rpc.L1)
 	if err != nil {
 		return err
 	}
 
-	var isUnretryableError bool
+	var unretryableErr error
 	if err := backoff.Retry(func() error {
 		if p.ctx.Err() != nil {
 			return nil
@@ -161,14 +177,18 @@ func (p *Prover) submitInvalidBlockProof(
 				return err
 			}
 
-			isUnretryableError = true
+			unretryableErr = err
 			return nil
 		}
 
+		p.setProvingJobStatus(blockID, provingJobSubmitted)
+
+		sentAt := time.Now()
 		if _, err := rpc.WaitReceipt(ctx, p.rpc.L1, tx); err != nil {
 			log.Warn("Failed to wait till transaction executed", "blockID", blockID, "txHash", tx.Hash(), "error", err)
 			return err
 		}
+		metrics.ProverSubmissionToReceiptTimer.UpdateSince(sentAt)
 
 		return nil
 	}, backoff.NewExponentialBackOff()); err != nil {
@@ -179,10 +199,12 @@ func (p *Prover) submitInvalidBlockProof(
 		return p.ctx.Err()
 	}
 
-	if isUnretryableError {
-		return nil
+	if unretryableErr != nil {
+		return fmt.Errorf("%w, TaikoL1.proveBlockInvalid: %v", errUnretryableProofSubmission, unretryableErr)
 	}
 
+	p.setProvingJobStatus(blockID, provingJobConfirmed)
+
 	log.Info(
 		"❎ Invalid block proved",
 		"blockID", proofWithHeader.BlockID,
@@ -0,0 +1,181 @@
+package prover
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/taikoxyz/taiko-client/bindings"
+	"github.com/taikoxyz/taiko-client/metrics"
+)
+
+// errProposedEventReorged is returned when the L1 block containing a BlockProposed event has been reorged out.
+var errProposedEventReorged = errors.New("BlockProposed event reorged out")
+
+// isL1BlockCanonical checks whether the given L1 block is still in the canonical chain.
+func (p *Prover) isL1BlockCanonical(ctx context.Context, height uint64, hash common.Hash) (bool, error) {
+	header, err := p.rpc.L1.HeaderByNumber(ctx, new(big.Int).SetUint64(height))
+	if err != nil {
+		// The L1 chain might become shorter after the reorg.
+		if errors.Is(err, ethereum.NotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return header.Hash() == hash, nil
+}
+
+// isProvingJobReorged checks whether the BlockProposed event, which the proving job of the given block
+// is created from, has been reorged out.
+func (p *Prover) isProvingJobReorged(ctx context.Context, blockID *big.Int) (bool, error) {
+	job, ok := p.provingJobs.get(blockID.Uint64())
+	// Jobs created by older versions have no L1 block hash recorded.
+	if !ok || job.L1BlockHash == (common.Hash{}) {
+		return false, nil
+	}
+
+	canonical, err := p.isL1BlockCanonical(ctx, job.L1Height, job.L1BlockHash)
+	if err != nil {
+		return false, err
+	}
+
+	return !canonical, nil
+}
+
+// checkProposedEventCanonical makes sure the BlockProposed event of the given block is still canonical
+// before submitting its proof, otherwise the evidence with outdated block metadata will be reverted.
+func (p *Prover) checkProposedEventCanonical(ctx context.Context, blockID *big.Int) error {
+	reorged, err := p.isProvingJobReorged(ctx, blockID)
+	if err != nil {
+		return fmt.Errorf("failed to check whether BlockProposed event is canonical: %w", err)
+	}
+
+	if reorged {
+		metrics.ProverReorgedProposedEventCounter.Inc(1)
+		return fmt.Errorf("%w, blockID: %d", errProposedEventReorged, blockID)
+	}
+
+	return nil
+}
+
+// refreshProposedEvent returns the given BlockProposed event if it is still canonical, otherwise fetches
+// the block's new BlockProposed event after the L1 reorg, and replaces the scheduled proving task's one.
+func (p *Prover) refreshProposedEvent(
+	ctx context.Context,
+	event *bindings.TaikoL1ClientBlockProposed,
+) (*bindings.TaikoL1ClientBlockProposed, error) {
+	canonical, err := p.isL1BlockCanonical(ctx, event.Raw.BlockNumber, event.Raw.BlockHash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check whether BlockProposed event is canonical: %w", err)
+	}
+
+	if canonical {
+		return event, nil
+	}
+
+	metrics.ProverReorgedProposedEventCounter.Inc(1)
+	log.Warn(
+		"BlockProposed event reorged out, fetch the new one",
+		"blockID", event.Id,
+		"l1Height", event.Raw.BlockNumber,
+		"l1Hash", event.Raw.BlockHash,
+	)
+
+	events, err := p.getBlockProposedEvents(ctx, event.Id.Uint64(), event.Id.Uint64())
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch the new BlockProposed event: %w", err)
+	}
+
+	newEvent, ok := events[event.Id.Uint64()]
+	if !ok {
+		return nil, fmt.Errorf("%w, no new event found, blockID: %d", errProposedEventReorged, event.Id)
+	}
+
+	p.provingScheduler.replace(newEvent)
+
+	return newEvent, nil
+}
+
+// cancelReorgedProofRequests cancels all in-flight proof requests whose BlockProposed events have been
+// reorged out, and re-queues their proving tasks, so that the proofs will be generated again with the
+// new block metadata.
+func (p *Prover) cancelReorgedProofRequests() {
+	p.cancelProofRequests(func(blockID *big.Int) bool {
+		reorged, err := p.isProvingJobReorged(p.ctx, blockID)
+		if err != nil {
+			log.Warn("Failed to check whether BlockProposed event is canonical", "blockID", blockID, "error", err)
+			return false
+		}
+
+		if reorged {
+			metrics.ProverReorgedProposedEventCounter.Inc(1)
+		}
+
+		return reorged
+	}, "BlockProposed event reorged out", true)
+}
+
+// rewindReorgedL1Current rewinds prover's cursors to the latest verified block, if the L1 block which the
+// L1Current cursor points to has been reorged out, so that the handled BlockProposed events in the reorged
+// L1 blocks will be fetched and proven again.
+func (p *Prover) rewindReorgedL1Current(ctx context.Context) error {
+	p.cursorMutex.Lock()
+	l1Current, l1CurrentHash := p.l1Current, p.l1CurrentHash
+	p.cursorMutex.Unlock()
+
+	// The cursor is not pointing to a BlockProposed event.
+	if l1CurrentHash == (common.Hash{}) {
+		return nil
+	}
+
+	canonical, err := p.isL1BlockCanonical(ctx, l1Current, l1CurrentHash)
+	if err != nil {
+		return fmt.Errorf("failed to check whether L1Current is canonical: %w", err)
+	}
+
+	if canonical {
+		return nil
+	}
+
+	stateVars, err := p.rpc.GetProtocolStateVariables(nil)
+	if err != nil {
+		return err
+	}
+
+	var l1Height uint64
+	if stateVars.LatestVerifiedID != 0 {
+		l1Origin, err := p.rpc.L2.L1OriginByID(ctx, new(big.Int).SetUint64(stateVars.LatestVerifiedID))
+		if err != nil {
+			return fmt.Errorf("failed to fetch latest verified block's l1Origin: %w", err)
+		}
+		l1Height = l1Origin.L1BlockHeight.Uint64()
+	}
+
+	p.cursorMutex.Lock()
+	defer p.cursorMutex.Unlock()
+
+	// The cursor has been advanced in the meantime.
+	if p.l1CurrentHash != l1CurrentHash {
+		return nil
+	}
+
+	log.Warn(
+		"L1Current cursor reorged out, rewind cursors to the latest verified block",
+		"l1Current", l1Current,
+		"l1CurrentHash", l1CurrentHash,
+		"newL1Current", l1Height,
+		"latestVerifiedID", stateVars.LatestVerifiedID,
+	)
+
+	p.l1Current = l1Height
+	p.l1CurrentHash = common.Hash{}
+	p.lastHandledBlockID = stateVars.LatestVerifiedID
+	metrics.ProverL1CurrentRewoundCounter.Inc(1)
+
+	return nil
+}
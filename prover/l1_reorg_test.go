@@ -0,0 +1,47 @@
+package prover
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/taikoxyz/taiko-client/testutils"
+)
+
+func (s *ProverTestSuite) TestIsL1BlockCanonical() {
+	head, err := s.p.rpc.L1.HeaderByNumber(context.Background(), nil)
+	s.Nil(err)
+
+	canonical, err := s.p.isL1BlockCanonical(context.Background(), head.Number.Uint64(), head.Hash())
+	s.Nil(err)
+	s.True(canonical)
+
+	canonical, err = s.p.isL1BlockCanonical(context.Background(), head.Number.Uint64(), testutils.RandomHash())
+	s.Nil(err)
+	s.False(canonical)
+
+	// Blocks beyond the L1 head are not canonical.
+	canonical, err = s.p.isL1BlockCanonical(context.Background(), head.Number.Uint64()+1024, head.Hash())
+	s.Nil(err)
+	s.False(canonical)
+}
+
+func (s *ProverTestSuite) TestCheckProposedEventCanonical() {
+	head, err := s.p.rpc.L1.HeaderByNumber(context.Background(), nil)
+	s.Nil(err)
+
+	provingJobs, err := newProvingJobStore("")
+	s.Nil(err)
+	s.p.provingJobs = provingJobs
+
+	// Jobs without the recorded L1 block hash are never considered as reorged.
+	s.Nil(s.p.checkProposedEventCanonical(context.Background(), common.Big1))
+	s.Nil(provingJobs.enqueue(1, head.Number.Uint64(), common.Hash{}))
+	s.Nil(s.p.checkProposedEventCanonical(context.Background(), common.Big1))
+
+	s.Nil(provingJobs.enqueue(1, head.Number.Uint64(), head.Hash()))
+	s.Nil(s.p.checkProposedEventCanonical(context.Background(), common.Big1))
+
+	s.Nil(provingJobs.enqueue(1, head.Number.Uint64(), testutils.RandomHash()))
+	s.True(errors.Is(s.p.checkProposedEventCanonical(context.Background(), common.Big1), errProposedEventReorged))
+}
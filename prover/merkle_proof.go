@@ -1,8 +1,13 @@
 package prover
 
 import (
+	"bytes"
+	"fmt"
+
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethdb/memorydb"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/ethereum/go-ethereum/trie"
@@ -42,3 +47,47 @@ func generateTrieProof(list types.DerivableList, i uint64) (common.Hash, []byte,
 
 	return trie.Hash(), proofBytes, nil
 }
+
+// verifyTrieProof verifies the given merkle proof of the i'th item in a MPT whose root is the given
+// root, and returns the proven item.
+func verifyTrieProof(root common.Hash, i uint64, proofBytes []byte) ([]byte, error) {
+	var proof [][]byte
+	if err := rlp.DecodeBytes(proofBytes, &proof); err != nil {
+		return nil, fmt.Errorf("failed to decode merkle proof: %w", err)
+	}
+
+	db := memorydb.New()
+	for _, node := range proof {
+		if err := db.Put(crypto.Keccak256(node), node); err != nil {
+			return nil, err
+		}
+	}
+
+	value, err := trie.VerifyProof(root, rlp.AppendUint64([]byte{}, i), db)
+	if err != nil {
+		return nil, fmt.Errorf("invalid merkle proof: %w", err)
+	}
+
+	if value == nil {
+		return nil, fmt.Errorf("item %d not found in trie %s", i, root)
+	}
+
+	return value, nil
+}
+
+// verifyTrieProofOfItem verifies that the given merkle proof proves the i'th item of the given elements
+// in a MPT whose root is the given root.
+func verifyTrieProofOfItem(root common.Hash, list types.DerivableList, i uint64, proofBytes []byte) error {
+	value, err := verifyTrieProof(root, i, proofBytes)
+	if err != nil {
+		return err
+	}
+
+	var item bytes.Buffer
+	list.EncodeIndex(int(i), &item)
+	if !bytes.Equal(value, item.Bytes()) {
+		return fmt.Errorf("proven item %d mismatch in trie %s", i, root)
+	}
+
+	return nil
+}
@@ -69,3 +69,27 @@ func (s *ProverTestSuite) TestGenerateTrieProof() {
 	s.Equal(testBlock.TxHash(), root)
 	s.NotEmpty(proof)
 }
+
+func (s *ProverTestSuite) TestVerifyTrieProof() {
+	blocks := generateTestChain()
+	testBlock := blocks[len(blocks)-1]
+
+	root, proof, err := generateTrieProof(testBlock.Transactions(), 1)
+	s.Nil(err)
+
+	value, err := verifyTrieProof(root, 1, proof)
+	s.Nil(err)
+	s.NotEmpty(value)
+	s.Nil(verifyTrieProofOfItem(root, testBlock.Transactions(), 1, proof))
+
+	// Proof of another item.
+	s.NotNil(verifyTrieProofOfItem(root, testBlock.Transactions(), 0, proof))
+
+	// Proof against another root.
+	_, err = verifyTrieProof(common.Hash{}, 1, proof)
+	s.NotNil(err)
+
+	// Malformed proof.
+	_, err = verifyTrieProof(root, 1, []byte{0x01})
+	s.NotNil(err)
+}
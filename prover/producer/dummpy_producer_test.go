@@ -1,6 +1,7 @@
 package producer
 
 import (
+	"context"
 	"crypto/rand"
 	"testing"
 	"time"
@@ -34,13 +35,15 @@ func TestRequestProof(t *testing.T) {
 		MixDigest:   randHash(),
 		Nonce:       types.BlockNonce{},
 	}
-	require.Nil(t, dummyProofProducer.RequestProof(
+	_, err := dummyProofProducer.RequestProof(
+		context.Background(),
 		&ProofRequestOptions{},
 		blockID,
 		&bindings.LibDataBlockMetadata{},
 		header,
 		resCh,
-	))
+	)
+	require.Nil(t, err)
 
 	res := <-resCh
 	require.Equal(t, res.BlockID, blockID)
@@ -48,6 +51,53 @@ func TestRequestProof(t *testing.T) {
 	require.NotEmpty(t, res.ZkProof)
 }
 
+func TestRequestProofCancel(t *testing.T) {
+	lower, upper := time.Hour, 2*time.Hour
+	dummyProofProducer := &DummyProofProducer{
+		RandomDummyProofDelayLowerBound: &lower,
+		RandomDummyProofDelayUpperBound: &upper,
+	}
+
+	resCh := make(chan *ProofWithHeader, 1)
+	handle, err := dummyProofProducer.RequestProof(
+		context.Background(),
+		&ProofRequestOptions{},
+		common.Big1,
+		&bindings.LibDataBlockMetadata{},
+		&types.Header{Number: common.Big1, Difficulty: common.Big0},
+		resCh,
+	)
+	require.Nil(t, err)
+	require.Equal(t, common.Big1, handle.BlockID)
+	require.False(t, handle.Cancelled())
+
+	handle.Cancel()
+	require.True(t, handle.Cancelled())
+	require.Empty(t, resCh)
+}
+
+func TestRequestProofOnRequested(t *testing.T) {
+	var (
+		resCh     = make(chan *ProofWithHeader, 1)
+		requested *ProofRequestHandle
+	)
+	handle, err := (&DummyProofProducer{}).RequestProof(
+		context.Background(),
+		&ProofRequestOptions{OnRequested: func(h *ProofRequestHandle) {
+			// The proof should not be sent before the request is tracked.
+			require.Empty(t, resCh)
+			requested = h
+		}},
+		common.Big1,
+		&bindings.LibDataBlockMetadata{},
+		&types.Header{Number: common.Big1, Difficulty: common.Big0},
+		resCh,
+	)
+	require.Nil(t, err)
+	require.Equal(t, handle, requested)
+	require.Equal(t, common.Big1, (<-resCh).BlockID)
+}
+
 func TestProofDelay(t *testing.T) {
 	dummyProofProducer := &DummyProofProducer{}
 	require.Equal(t, time.Duration(0), dummyProofProducer.proofDelay())
@@ -1,6 +1,7 @@
 package producer
 
 import (
+	"context"
 	"math/big"
 	"math/rand"
 	"time"
@@ -18,12 +19,13 @@ type DummyProofProducer struct {
 
 // RequestProof implements the ProofProducer interface.
 func (d *DummyProofProducer) RequestProof(
+	ctx context.Context,
 	opts *ProofRequestOptions,
 	blockID *big.Int,
 	meta *bindings.LibDataBlockMetadata,
 	header *types.Header,
 	resultCh chan *ProofWithHeader,
-) error {
+) (*ProofRequestHandle, error) {
 	log.Info(
 		"Request dummy proof",
 		"blockID", blockID,
@@ -32,13 +34,28 @@ func (d *DummyProofProducer) RequestProof(
 		"hash", header.Hash(),
 	)
 
-	time.AfterFunc(d.proofDelay(), func() {
-		resultCh <- &ProofWithHeader{
-			BlockID: blockID, Meta: meta, Header: header, ZkProof: []byte{0xff},
+	ctx, handle := newProofRequestHandle(ctx, blockID)
+	handle.setBackend("dummy")
+	opts.onRequested(handle)
+
+	go func() {
+		select {
+		case <-ctx.Done():
+			log.Info("Dummy proof request cancelled", "blockID", blockID)
+			handle.finish(ctx.Err())
+			return
+		case <-time.After(d.proofDelay()):
+		}
+
+		select {
+		case <-ctx.Done():
+			handle.finish(ctx.Err())
+		case resultCh <- &ProofWithHeader{BlockID: blockID, Meta: meta, Header: header, ZkProof: []byte{0xff}}:
+			handle.finish(nil)
 		}
-	})
+	}()
 
-	return nil
+	return handle, nil
 }
 
 // proofDelay calculates a random proof delay between the bounds.
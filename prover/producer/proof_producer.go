@@ -1,7 +1,9 @@
 package producer
 
 import (
+	"context"
 	"math/big"
+	"sync"
 
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/taikoxyz/taiko-client/bindings"
@@ -14,6 +16,16 @@ type ProofRequestOptions struct {
 	L2Endpoint string                         // a L2 execution engine's RPC endpoint
 	Retry      bool                           // retry proof computation if error
 	Param      string                         // parameter file to use
+	// Called with the handle of the request before the proof generation starts, so that the
+	// request can be tracked before its proof is sent to the result channel.
+	OnRequested func(handle *ProofRequestHandle)
+}
+
+// onRequested calls the OnRequested callback with the given handle, if there is one.
+func (opts *ProofRequestOptions) onRequested(handle *ProofRequestHandle) {
+	if opts.OnRequested != nil {
+		opts.OnRequested(handle)
+	}
 }
 
 type ProofWithHeader struct {
@@ -23,12 +35,85 @@ type ProofWithHeader struct {
 	ZkProof []byte
 }
 
+// ProofRequestHandle represents a requested proof, which can be used to cancel the request,
+// or to wait for the request to finish.
+type ProofRequestHandle struct {
+	BlockID *big.Int
+	ctx     context.Context
+	cancel  context.CancelFunc
+	done    chan struct{}
+	err     error
+	once    sync.Once
+
+	backend      string
+	backendMutex sync.Mutex
+}
+
+// newProofRequestHandle creates a new cancellable proof request handle, the returned context
+// will be done once the request is cancelled or the given parent context is done.
+func newProofRequestHandle(parent context.Context, blockID *big.Int) (context.Context, *ProofRequestHandle) {
+	ctx, cancel := context.WithCancel(parent)
+	return ctx, &ProofRequestHandle{BlockID: blockID, ctx: ctx, cancel: cancel, done: make(chan struct{})}
+}
+
+// Cancel cancels the proof request, no proof will be sent to the result channel after cancelling.
+func (h *ProofRequestHandle) Cancel() {
+	h.cancel()
+}
+
+// Cancelled returns whether the proof request has been cancelled.
+func (h *ProofRequestHandle) Cancelled() bool {
+	return h.ctx.Err() != nil
+}
+
+// Done returns a channel which will be closed once the proof request is finished, either
+// the proof has been sent to the result channel, or the request failed.
+func (h *ProofRequestHandle) Done() <-chan struct{} {
+	return h.done
+}
+
+// Err returns the error of a finished proof request, nil if the proof has been sent to
+// the result channel.
+func (h *ProofRequestHandle) Err() error {
+	return h.err
+}
+
+// Backend returns the proof producer backend which is generating the proof, empty if the
+// request has not been dispatched to any backend yet.
+func (h *ProofRequestHandle) Backend() string {
+	h.backendMutex.Lock()
+	defer h.backendMutex.Unlock()
+
+	return h.backend
+}
+
+// setBackend records the proof producer backend which is generating the proof.
+func (h *ProofRequestHandle) setBackend(backend string) {
+	if h == nil {
+		return
+	}
+
+	h.backendMutex.Lock()
+	defer h.backendMutex.Unlock()
+
+	h.backend = backend
+}
+
+// finish marks the proof request as finished with the given error.
+func (h *ProofRequestHandle) finish(err error) {
+	h.once.Do(func() {
+		h.err = err
+		close(h.done)
+	})
+}
+
 type ProofProducer interface {
 	RequestProof(
+		ctx context.Context,
 		opts *ProofRequestOptions,
 		blockID *big.Int,
 		meta *bindings.LibDataBlockMetadata,
 		header *types.Header,
 		resultCh chan *ProofWithHeader,
-	) error
+	) (*ProofRequestHandle, error)
 }
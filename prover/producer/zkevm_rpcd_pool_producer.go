@@ -0,0 +1,244 @@
+package producer
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/taikoxyz/taiko-client/bindings"
+	"github.com/taikoxyz/taiko-client/metrics"
+)
+
+var (
+	errNoRpcdEndpoints       = errors.New("no ZKEVM RPCD endpoints given")
+	errNoHealthyRpcdBackends = errors.New("no healthy ZKEVM RPCD backends")
+)
+
+const (
+	// Default interval to check the health of all ZKEVM RPCD backends.
+	defaultHealthCheckInterval = 30 * time.Second
+)
+
+// rpcdBackend represents a ZKEVM RPCD service in the pool, along with its load and metrics.
+type rpcdBackend struct {
+	producer *ZkevmRpcdProducer
+	healthy  bool
+	inFlight int64
+	metrics  *metrics.ProverRpcdMetrics
+}
+
+// ZkevmRpcdPoolProducer is responsible for requesting zk proofs from a pool of ZKEVM RPCD services,
+// each request will be sent to the least busy healthy backend, and will be re-dispatched to another
+// backend if the current one dies before the proof is generated.
+type ZkevmRpcdPoolProducer struct {
+	backends            []*rpcdBackend
+	HealthCheckInterval time.Duration
+	mutex               sync.Mutex
+}
+
+// NewZkevmRpcdPoolProducer creates a new `ZkevmRpcdPoolProducer` instance with the given ZKEVM RPCD
+// endpoints, and starts checking their health periodically until the given context is done. A proof
+// request will fail if the proof is not generated within the given timeout, zero means no timeout.
+func NewZkevmRpcdPoolProducer(
+	ctx context.Context,
+	rpcdEndpoints []string,
+	proofTimeout time.Duration,
+) (*ZkevmRpcdPoolProducer, error) {
+	if len(rpcdEndpoints) == 0 {
+		return nil, errNoRpcdEndpoints
+	}
+
+	p := &ZkevmRpcdPoolProducer{HealthCheckInterval: defaultHealthCheckInterval}
+	for _, endpoint := range rpcdEndpoints {
+		p.backends = append(p.backends, &rpcdBackend{
+			producer: newZkevmRpcdProducer(endpoint, proofTimeout),
+			metrics:  metrics.NewProverRpcdMetrics(endpoint),
+		})
+	}
+
+	if p.checkHealth() == 0 {
+		return nil, errNoHealthyRpcdBackends
+	}
+
+	go p.healthCheckLoop(ctx)
+
+	return p, nil
+}
+
+// RequestProof implements the ProofProducer interface.
+func (p *ZkevmRpcdPoolProducer) RequestProof(
+	ctx context.Context,
+	opts *ProofRequestOptions,
+	blockID *big.Int,
+	meta *bindings.LibDataBlockMetadata,
+	header *types.Header,
+	resultCh chan *ProofWithHeader,
+) (*ProofRequestHandle, error) {
+	log.Info(
+		"Request proof from ZKEVM RPCD pool",
+		"blockID", blockID,
+		"beneficiary", meta.Beneficiary,
+		"height", header.Number,
+		"hash", header.Hash(),
+	)
+
+	ctx, handle := newProofRequestHandle(ctx, blockID)
+	opts.onRequested(handle)
+
+	go func() {
+		proof, err := p.dispatch(ctx, opts, handle)
+		if err != nil {
+			if ctx.Err() != nil {
+				log.Info("Proof request cancelled", "blockID", blockID)
+				handle.finish(ctx.Err())
+				return
+			}
+			log.Error("Failed to request proof from ZKEVM RPCD pool", "blockID", blockID, "error", err)
+			handle.finish(err)
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			handle.finish(ctx.Err())
+		case resultCh <- &ProofWithHeader{BlockID: blockID, Header: header, Meta: meta, ZkProof: proof}:
+			handle.finish(nil)
+		}
+	}()
+
+	return handle, nil
+}
+
+// dispatch sends the proof request to the least busy healthy backend, and keeps re-dispatching
+// it to another backend if the current one dies before the proof is generated, the selected backend
+// will be recorded in the given handle.
+func (p *ZkevmRpcdPoolProducer) dispatch(
+	ctx context.Context,
+	opts *ProofRequestOptions,
+	handle *ProofRequestHandle,
+) ([]byte, error) {
+	for {
+		backend := p.acquire()
+		if backend == nil {
+			log.Warn("No healthy ZKEVM RPCD backends, wait for the next health check", "height", opts.Height)
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-time.After(p.HealthCheckInterval):
+			}
+			continue
+		}
+
+		handle.setBackend(backend.producer.RpcdEndpoint)
+
+		start := time.Now()
+		proof, err := backend.producer.callProverDaemon(ctx, opts)
+		p.release(backend)
+
+		if err == nil {
+			backend.metrics.LatencyTimer.UpdateSince(start)
+			return proof, nil
+		}
+
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+
+		backend.metrics.FailuresCounter.Inc(1)
+
+		// If the backend is still healthy, the error is about generating this proof,
+		// so there is no need to re-dispatch it.
+		if healthErr := backend.producer.checkHealth(); healthErr == nil {
+			return nil, err
+		}
+
+		p.setHealthy(backend, false)
+		log.Warn(
+			"ZKEVM RPCD backend died while generating proof, re-dispatch the request",
+			"endpoint", backend.producer.RpcdEndpoint,
+			"height", opts.Height,
+			"error", err,
+		)
+	}
+}
+
+// acquire returns the healthy backend with the least in-flight requests, and increases its load.
+func (p *ZkevmRpcdPoolProducer) acquire() *rpcdBackend {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	var selected *rpcdBackend
+	for _, backend := range p.backends {
+		if !backend.healthy {
+			continue
+		}
+
+		if selected == nil || backend.inFlight < selected.inFlight {
+			selected = backend
+		}
+	}
+
+	if selected != nil {
+		selected.inFlight++
+		selected.metrics.QueueDepthGauge.Update(selected.inFlight)
+	}
+
+	return selected
+}
+
+// release decreases the load of the given backend.
+func (p *ZkevmRpcdPoolProducer) release(backend *rpcdBackend) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	backend.inFlight--
+	backend.metrics.QueueDepthGauge.Update(backend.inFlight)
+}
+
+// setHealthy updates the health status of the given backend.
+func (p *ZkevmRpcdPoolProducer) setHealthy(backend *rpcdBackend, healthy bool) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if backend.healthy && !healthy {
+		backend.metrics.UnhealthyCounter.Inc(1)
+	}
+
+	backend.healthy = healthy
+}
+
+// checkHealth checks the health of all backends, and returns the number of healthy ones.
+func (p *ZkevmRpcdPoolProducer) checkHealth() int {
+	var healthyCount int
+	for _, backend := range p.backends {
+		err := backend.producer.checkHealth()
+		if err != nil {
+			log.Warn("ZKEVM RPCD backend is unhealthy", "endpoint", backend.producer.RpcdEndpoint, "error", err)
+		} else {
+			healthyCount++
+		}
+
+		p.setHealthy(backend, err == nil)
+	}
+
+	return healthyCount
+}
+
+// healthCheckLoop checks the health of all backends periodically.
+func (p *ZkevmRpcdPoolProducer) healthCheckLoop(ctx context.Context) {
+	ticker := time.NewTicker(p.HealthCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			p.checkHealth()
+		}
+	}
+}
@@ -0,0 +1,156 @@
+package producer
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/metrics"
+	"github.com/stretchr/testify/require"
+	"github.com/taikoxyz/taiko-client/bindings"
+)
+
+// newDyingProverd creates a mock ZKEVM RPCD service, which dies after receiving the first
+// `proof` request.
+func newDyingProverd() (*httptest.Server, *int32) {
+	var dead int32
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.LoadInt32(&dead) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		if r.URL.Path == "/health" {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+
+		atomic.StoreInt32(&dead, 1)
+		w.WriteHeader(http.StatusInternalServerError)
+	})), &dead
+}
+
+func newTestPoolProducer(t *testing.T, endpoints ...string) *ZkevmRpcdPoolProducer {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	pool, err := NewZkevmRpcdPoolProducer(ctx, endpoints, 0)
+	require.Nil(t, err)
+
+	for _, backend := range pool.backends {
+		backend.producer.ProofPollingInterval = 10 * time.Millisecond
+	}
+
+	return pool
+}
+
+func TestNewZkevmRpcdPoolProducer(t *testing.T) {
+	_, err := NewZkevmRpcdPoolProducer(context.Background(), []string{}, 0)
+	require.ErrorIs(t, err, errNoRpcdEndpoints)
+
+	unhealthy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer unhealthy.Close()
+
+	_, err = NewZkevmRpcdPoolProducer(context.Background(), []string{unhealthy.URL}, 0)
+	require.ErrorIs(t, err, errNoHealthyRpcdBackends)
+
+	proof := randHash().Bytes()
+	proverd := newMockProverd(t, func(n int64) string {
+		return `{"jsonrpc":"2.0","id":1,"result":{"aggregation":{"proof":"` + hexutil.Encode(proof) + `"}}}`
+	})
+	defer proverd.Close()
+
+	// Unhealthy backends should be skipped.
+	pool := newTestPoolProducer(t, unhealthy.URL, proverd.URL)
+
+	resCh := make(chan *ProofWithHeader, 1)
+	header := &types.Header{Number: common.Big256, Difficulty: common.Big0}
+	_, err = pool.RequestProof(
+		context.Background(),
+		&ProofRequestOptions{Height: header.Number},
+		common.Big32,
+		&bindings.LibDataBlockMetadata{},
+		header,
+		resCh,
+	)
+	require.Nil(t, err)
+
+	res := <-resCh
+	require.Equal(t, common.Big32, res.BlockID)
+	require.Equal(t, proof, res.ZkProof)
+}
+
+func TestZkevmRpcdPoolProducerLeastBusy(t *testing.T) {
+	proverd0 := newMockProverd(t, func(n int64) string { return `{"jsonrpc":"2.0","id":1,"result":null}` })
+	defer proverd0.Close()
+	proverd1 := newMockProverd(t, func(n int64) string { return `{"jsonrpc":"2.0","id":1,"result":null}` })
+	defer proverd1.Close()
+
+	pool := newTestPoolProducer(t, proverd0.URL, proverd1.URL)
+
+	backend0 := pool.acquire()
+	backend1 := pool.acquire()
+	require.NotEqual(t, backend0, backend1)
+
+	pool.release(backend1)
+	require.Equal(t, backend1, pool.acquire())
+
+	pool.setHealthy(backend0, false)
+	pool.setHealthy(backend1, false)
+	require.Nil(t, pool.acquire())
+}
+
+func TestZkevmRpcdPoolProducerRedispatch(t *testing.T) {
+	dying, dead := newDyingProverd()
+	defer dying.Close()
+
+	proof := randHash().Bytes()
+	proverd := newMockProverd(t, func(n int64) string {
+		return `{"jsonrpc":"2.0","id":1,"result":{"aggregation":{"proof":"` + hexutil.Encode(proof) + `"}}}`
+	})
+	defer proverd.Close()
+
+	pool := newTestPoolProducer(t, dying.URL, proverd.URL)
+
+	// The request should be sent to the first backend at first, and then re-dispatched
+	// to the second one after the first one dies.
+	ctx, handle := newProofRequestHandle(context.Background(), common.Big1)
+	res, err := pool.dispatch(ctx, &ProofRequestOptions{Height: common.Big1}, handle)
+	require.Nil(t, err)
+	require.Equal(t, proof, res)
+	require.Equal(t, proverd.URL, handle.Backend())
+	require.Equal(t, int32(1), atomic.LoadInt32(dead))
+	require.False(t, pool.backends[0].healthy)
+	require.Zero(t, pool.backends[0].inFlight)
+	require.Zero(t, pool.backends[1].inFlight)
+
+	// Metrics should be keyed by the backend endpoints.
+	for _, endpoint := range []string{dying.URL, proverd.URL} {
+		name := strings.NewReplacer(".", "_", ":", "_").Replace(strings.TrimPrefix(endpoint, "http://"))
+		require.NotNil(t, metrics.DefaultRegistry.Get("prover/rpcd/"+name+"/unhealthy"))
+	}
+}
+
+func TestZkevmRpcdPoolProducerError(t *testing.T) {
+	proverd := newMockProverd(t, func(n int64) string {
+		return `{"jsonrpc":"2.0","id":1,"error":{"code":-32000,"message":"mock error"}}`
+	})
+	defer proverd.Close()
+
+	pool := newTestPoolProducer(t, proverd.URL)
+
+	// Errors returned by healthy backends should not be re-dispatched.
+	_, err := pool.dispatch(context.Background(), &ProofRequestOptions{Height: common.Big1}, nil)
+	require.ErrorContains(t, err, "mock error")
+	require.True(t, pool.backends[0].healthy)
+}
@@ -1,43 +1,135 @@
 package producer
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"io"
 	"math/big"
 	"net/http"
+	"time"
 
+	"github.com/cenkalti/backoff/v4"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/taikoxyz/taiko-client/bindings"
 )
 
 var (
-	errRpcdUnhealthy = errors.New("ZKEVM RPCD endpoint is unhealthy")
+	errRpcdUnhealthy   = errors.New("ZKEVM RPCD endpoint is unhealthy")
+	errProofGenerating = errors.New("proof is generating")
+	errEmptyProof      = errors.New("empty proof returned by ZKEVM RPCD service")
+	errProofTimeout    = errors.New("proof generation timeout")
 )
 
+const (
+	// Default interval to poll the ZKEVM RPCD service for the requested proof.
+	defaultProofPollingInterval = 10 * time.Second
+	// Default maximum number of retries when the ZKEVM RPCD service returns an error.
+	defaultMaxErrorRetries = 3
+)
+
+// ZkevmRpcdProducer is responsible for requesting zk proofs from the given ZKEVM RPCD service.
 type ZkevmRpcdProducer struct {
-	RpcdEndpoint string
+	RpcdEndpoint         string
+	ProofPollingInterval time.Duration
+	ProofTimeout         time.Duration // zero means no timeout
+	MaxErrorRetries      uint64
+	httpClient           *http.Client
 }
 
-func NewZkevmRpcdProducer(rpcdEndpoint string) (*ZkevmRpcdProducer, error) {
-	resp, err := http.Get(rpcdEndpoint + "/health")
-	if err != nil {
+// RequestProofBody represents the JSON body for requesting the proof.
+type RequestProofBody struct {
+	JsonRPC string                   `json:"jsonrpc"`
+	ID      *big.Int                 `json:"id"`
+	Method  string                   `json:"method"`
+	Params  []*RequestProofBodyParam `json:"params"`
+}
+
+// RequestProofBodyParam represents the request body params of a `proof` JSON-RPC call.
+type RequestProofBodyParam struct {
+	Block     *big.Int `json:"block"`
+	L2RPC     string   `json:"rpc"`
+	Retry     bool     `json:"retry"`
+	Param     string   `json:"param"`
+	Aggregate bool     `json:"aggregate"`
+}
+
+// RequestProofBodyResponse represents the JSON body of the response of the proof requests.
+type RequestProofBodyResponse struct {
+	JsonRPC string      `json:"jsonrpc"`
+	ID      *big.Int    `json:"id"`
+	Result  *RpcdOutput `json:"result"`
+	Error   *struct {
+		Code    int64  `json:"code"`
+		Message string `json:"message"`
+	} `json:"error,omitempty"`
+}
+
+// RpcdOutput represents a proof output of the ZKEVM RPCD service.
+type RpcdOutput struct {
+	Circuit struct {
+		Instances []string `json:"instance"`
+		Proof     string   `json:"proof"`
+	} `json:"circuit"`
+	Aggregation struct {
+		Instances []string `json:"instance"`
+		Proof     string   `json:"proof"`
+	} `json:"aggregation"`
+}
+
+// NewZkevmRpcdProducer creates a new `ZkevmRpcdProducer` instance, and checks whether
+// the given ZKEVM RPCD service is healthy. A proof request will fail if the proof is not
+// generated within the given timeout, zero means no timeout.
+func NewZkevmRpcdProducer(rpcdEndpoint string, proofTimeout time.Duration) (*ZkevmRpcdProducer, error) {
+	p := newZkevmRpcdProducer(rpcdEndpoint, proofTimeout)
+	if err := p.checkHealth(); err != nil {
 		return nil, err
 	}
-	if resp.StatusCode != 200 {
-		return nil, errRpcdUnhealthy
+
+	return p, nil
+}
+
+// newZkevmRpcdProducer creates a new `ZkevmRpcdProducer` instance without checking
+// the given ZKEVM RPCD service's health.
+func newZkevmRpcdProducer(rpcdEndpoint string, proofTimeout time.Duration) *ZkevmRpcdProducer {
+	return &ZkevmRpcdProducer{
+		RpcdEndpoint:         rpcdEndpoint,
+		ProofPollingInterval: defaultProofPollingInterval,
+		ProofTimeout:         proofTimeout,
+		MaxErrorRetries:      defaultMaxErrorRetries,
+		httpClient:           &http.Client{Timeout: time.Minute},
+	}
+}
+
+// checkHealth checks whether the ZKEVM RPCD service is healthy.
+func (p *ZkevmRpcdProducer) checkHealth() error {
+	resp, err := p.httpClient.Get(p.RpcdEndpoint + "/health")
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return errRpcdUnhealthy
 	}
 
-	return &ZkevmRpcdProducer{RpcdEndpoint: rpcdEndpoint}, nil
+	return nil
 }
 
 // RequestProof implements the ProofProducer interface.
-func (d *ZkevmRpcdProducer) RequestProof(
+func (p *ZkevmRpcdProducer) RequestProof(
+	ctx context.Context,
 	opts *ProofRequestOptions,
 	blockID *big.Int,
 	meta *bindings.LibDataBlockMetadata,
 	header *types.Header,
 	resultCh chan *ProofWithHeader,
-) error {
+) (*ProofRequestHandle, error) {
 	log.Info(
 		"Request proof from ZKEVM RPCD service",
 		"blockID", blockID,
@@ -46,14 +138,148 @@ func (d *ZkevmRpcdProducer) RequestProof(
 		"hash", header.Hash(),
 	)
 
-	// TODO: call zkevm RPCD to get a proof.
+	ctx, handle := newProofRequestHandle(ctx, blockID)
+	handle.setBackend(p.RpcdEndpoint)
+	opts.onRequested(handle)
+
 	go func() {
-		resultCh <- &ProofWithHeader{
-			BlockID: blockID,
-			Header:  header,
-			Meta:    meta,
-			ZkProof: []byte{0x00},
+		proof, err := p.callProverDaemon(ctx, opts)
+		if err != nil {
+			if ctx.Err() != nil {
+				log.Info("Proof request cancelled", "blockID", blockID)
+				handle.finish(ctx.Err())
+				return
+			}
+			log.Error("Failed to request proof from ZKEVM RPCD service", "blockID", blockID, "error", err)
+			handle.finish(err)
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			handle.finish(ctx.Err())
+		case resultCh <- &ProofWithHeader{BlockID: blockID, Header: header, Meta: meta, ZkProof: proof}:
+			handle.finish(nil)
 		}
 	}()
-	return nil
+
+	return handle, nil
+}
+
+// callProverDaemon keeps polling the ZKEVM RPCD service until the requested proof is generated,
+// or the proof generation times out, or the given context is done.
+func (p *ZkevmRpcdProducer) callProverDaemon(ctx context.Context, opts *ProofRequestOptions) ([]byte, error) {
+	var (
+		proof      []byte
+		errRetries uint64
+		start      = time.Now()
+	)
+
+	if err := backoff.Retry(func() error {
+		output, err := p.requestProof(ctx, opts)
+		if err != nil {
+			// The ZKEVM RPCD service failed to generate the proof, only resend the request
+			// when retrying is enabled.
+			if !opts.Retry || errRetries >= p.MaxErrorRetries {
+				return backoff.Permanent(err)
+			}
+
+			errRetries++
+			log.Warn("Retry requesting proof from ZKEVM RPCD service", "height", opts.Height, "error", err)
+			return err
+		}
+
+		if output == nil {
+			if p.ProofTimeout != 0 && time.Since(start) >= p.ProofTimeout {
+				return backoff.Permanent(
+					fmt.Errorf("%w, height: %d, timeout: %s", errProofTimeout, opts.Height, p.ProofTimeout),
+				)
+			}
+
+			log.Debug("Proof is generating", "height", opts.Height, "time", time.Since(start))
+			return errProofGenerating
+		}
+
+		if proof, err = decodeRpcdOutput(output); err != nil {
+			return backoff.Permanent(err)
+		}
+
+		return nil
+	}, backoff.WithContext(backoff.NewConstantBackOff(p.ProofPollingInterval), ctx)); err != nil {
+		return nil, err
+	}
+
+	log.Info("Proof generated", "height", opts.Height, "time", time.Since(start))
+
+	return proof, nil
+}
+
+// requestProof sends a `proof` JSON-RPC call to the ZKEVM RPCD service, a nil output
+// will be returned if the proof is still generating.
+func (p *ZkevmRpcdProducer) requestProof(ctx context.Context, opts *ProofRequestOptions) (*RpcdOutput, error) {
+	reqBody := RequestProofBody{
+		JsonRPC: "2.0",
+		ID:      common.Big1,
+		Method:  "proof",
+		Params: []*RequestProofBodyParam{{
+			Block:     opts.Height,
+			L2RPC:     opts.L2Endpoint,
+			Retry:     opts.Retry,
+			Param:     opts.Param,
+			Aggregate: true,
+		}},
+	}
+
+	jsonValue, err := json.Marshal(reqBody)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.RpcdEndpoint, bytes.NewBuffer(jsonValue))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := p.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to request proof, id: %d, statusCode: %d", opts.Height, res.StatusCode)
+	}
+
+	resBytes, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var output RequestProofBodyResponse
+	if err := json.Unmarshal(resBytes, &output); err != nil {
+		return nil, err
+	}
+
+	if output.Error != nil {
+		return nil, fmt.Errorf("ZKEVM RPCD error, code: %d, message: %s", output.Error.Code, output.Error.Message)
+	}
+
+	return output.Result, nil
+}
+
+// decodeRpcdOutput decodes the aggregated proof bytes from the given ZKEVM RPCD output, TaikoL1
+// only accepts the aggregated proofs, so the circuit proof is never used.
+func decodeRpcdOutput(output *RpcdOutput) ([]byte, error) {
+	proofHex := output.Aggregation.Proof
+	if len(proofHex) == 0 {
+		return nil, errEmptyProof
+	}
+
+	proof, err := hexutil.Decode(proofHex)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode proof: %w", err)
+	}
+
+	return proof, nil
 }
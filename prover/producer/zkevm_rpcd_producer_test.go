@@ -1,21 +1,64 @@
 package producer
 
 import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/stretchr/testify/require"
 	"github.com/taikoxyz/taiko-client/bindings"
 )
 
+// newMockProverd creates a mock ZKEVM RPCD service, which responds the `proof` requests
+// with the given handler, the handler receives the number of the current request.
+func newMockProverd(t *testing.T, handler func(n int64) string) *httptest.Server {
+	var requests int64
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/health" {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+
+		var body RequestProofBody
+		require.Nil(t, json.NewDecoder(r.Body).Decode(&body))
+		require.Equal(t, "proof", body.Method)
+		require.Len(t, body.Params, 1)
+
+		_, err := w.Write([]byte(handler(atomic.AddInt64(&requests, 1))))
+		require.Nil(t, err)
+	}))
+}
+
 func TestNewZkevmRpcdProducer(t *testing.T) {
-	_, err := NewZkevmRpcdProducer("http://localhost:28551")
+	unhealthy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer unhealthy.Close()
+
+	_, err := NewZkevmRpcdProducer(unhealthy.URL, 0)
 	require.EqualError(t, err, errRpcdUnhealthy.Error())
 
-	dummyZKEvmProducer, err := NewZkevmRpcdProducer("http://localhost:18545")
+	proof := randHash().Bytes()
+	proverd := newMockProverd(t, func(n int64) string {
+		// Proof is still generating in the first two requests.
+		if n < 3 {
+			return `{"jsonrpc":"2.0","id":1,"result":null}`
+		}
+		return `{"jsonrpc":"2.0","id":1,"result":{"aggregation":{"proof":"` + hexutil.Encode(proof) + `"}}}`
+	})
+	defer proverd.Close()
+
+	zkevmRpcdProducer, err := NewZkevmRpcdProducer(proverd.URL, 0)
 	require.Nil(t, err)
+	zkevmRpcdProducer.ProofPollingInterval = 10 * time.Millisecond
 
 	resCh := make(chan *ProofWithHeader, 1)
 
@@ -36,16 +79,114 @@ func TestNewZkevmRpcdProducer(t *testing.T) {
 		MixDigest:   randHash(),
 		Nonce:       types.BlockNonce{},
 	}
-	require.Nil(t, dummyZKEvmProducer.RequestProof(
-		&ProofRequestOptions{},
+	_, err = zkevmRpcdProducer.RequestProof(
+		context.Background(),
+		&ProofRequestOptions{Height: header.Number},
 		blockID,
 		&bindings.LibDataBlockMetadata{},
 		header,
 		resCh,
-	))
+	)
+	require.Nil(t, err)
 
 	res := <-resCh
 	require.Equal(t, res.BlockID, blockID)
 	require.Equal(t, res.Header, header)
-	require.NotEmpty(t, res.ZkProof)
+	require.Equal(t, proof, res.ZkProof)
+}
+
+func TestZkevmRpcdProducerRetry(t *testing.T) {
+	proof := randHash().Bytes()
+	proverd := newMockProverd(t, func(n int64) string {
+		if n == 1 {
+			return `{"jsonrpc":"2.0","id":1,"error":{"code":-32000,"message":"mock error"}}`
+		}
+		return `{"jsonrpc":"2.0","id":1,"result":{"aggregation":{"proof":"` + hexutil.Encode(proof) + `"}}}`
+	})
+	defer proverd.Close()
+
+	zkevmRpcdProducer, err := NewZkevmRpcdProducer(proverd.URL, 0)
+	require.Nil(t, err)
+	zkevmRpcdProducer.ProofPollingInterval = 10 * time.Millisecond
+
+	// Resend the request after an error response, when retrying is enabled.
+	res, err := zkevmRpcdProducer.callProverDaemon(
+		context.Background(),
+		&ProofRequestOptions{Height: common.Big1, Retry: true},
+	)
+	require.Nil(t, err)
+	require.Equal(t, proof, res)
+}
+
+func TestZkevmRpcdProducerError(t *testing.T) {
+	proverd := newMockProverd(t, func(n int64) string {
+		return `{"jsonrpc":"2.0","id":1,"error":{"code":-32000,"message":"mock error"}}`
+	})
+	defer proverd.Close()
+
+	zkevmRpcdProducer, err := NewZkevmRpcdProducer(proverd.URL, 0)
+	require.Nil(t, err)
+	zkevmRpcdProducer.ProofPollingInterval = 10 * time.Millisecond
+
+	_, err = zkevmRpcdProducer.callProverDaemon(context.Background(), &ProofRequestOptions{Height: common.Big1})
+	require.ErrorContains(t, err, "mock error")
+
+	_, err = zkevmRpcdProducer.callProverDaemon(
+		context.Background(),
+		&ProofRequestOptions{Height: common.Big1, Retry: true},
+	)
+	require.ErrorContains(t, err, "mock error")
+}
+
+func TestZkevmRpcdProducerCancel(t *testing.T) {
+	proverd := newMockProverd(t, func(n int64) string { return `{"jsonrpc":"2.0","id":1,"result":null}` })
+	defer proverd.Close()
+
+	zkevmRpcdProducer, err := NewZkevmRpcdProducer(proverd.URL, 0)
+	require.Nil(t, err)
+	zkevmRpcdProducer.ProofPollingInterval = 10 * time.Millisecond
+
+	// Polling should stop once the context is done.
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err = zkevmRpcdProducer.callProverDaemon(ctx, &ProofRequestOptions{Height: common.Big1})
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	// Polling should stop once the proof generation times out.
+	zkevmRpcdProducer.ProofTimeout = 50 * time.Millisecond
+	_, err = zkevmRpcdProducer.callProverDaemon(context.Background(), &ProofRequestOptions{Height: common.Big1})
+	require.ErrorIs(t, err, errProofTimeout)
+	zkevmRpcdProducer.ProofTimeout = 0
+
+	resCh := make(chan *ProofWithHeader, 1)
+	handle, err := zkevmRpcdProducer.RequestProof(
+		context.Background(),
+		&ProofRequestOptions{Height: common.Big1},
+		common.Big1,
+		&bindings.LibDataBlockMetadata{},
+		&types.Header{Number: common.Big1, Difficulty: common.Big0},
+		resCh,
+	)
+	require.Nil(t, err)
+
+	handle.Cancel()
+	require.True(t, handle.Cancelled())
+	require.Empty(t, resCh)
+}
+
+func TestDecodeRpcdOutput(t *testing.T) {
+	_, err := decodeRpcdOutput(&RpcdOutput{})
+	require.ErrorIs(t, err, errEmptyProof)
+
+	// The circuit proof should not be used, since it will be rejected by TaikoL1.
+	output := &RpcdOutput{}
+	output.Circuit.Proof = hexutil.Encode(randHash().Bytes())
+	_, err = decodeRpcdOutput(output)
+	require.ErrorIs(t, err, errEmptyProof)
+
+	output = &RpcdOutput{}
+	output.Aggregation.Proof = "0xzz"
+	_, err = decodeRpcdOutput(output)
+	require.NotNil(t, err)
 }
@@ -0,0 +1,252 @@
+package prover
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/taikoxyz/taiko-client/bindings/encoding"
+	"github.com/taikoxyz/taiko-client/cmd/flags"
+	fileUtil "github.com/taikoxyz/taiko-client/pkg/file_util"
+	"github.com/taikoxyz/taiko-client/pkg/rpc"
+	"github.com/urfave/cli/v2"
+)
+
+// proofArtifact represents a generated proof with all its evidence inputs, which can be used to
+// re-send the proof submission transaction later.
+type proofArtifact struct {
+	BlockID      uint64      `json:"blockID"`
+	BlockHash    common.Hash `json:"blockHash"`
+	IsValidProof bool        `json:"isValidProof"`
+	// Encoded TaikoL1Evidence.
+	Evidence hexutil.Bytes `json:"evidence"`
+	// Valid block proof only.
+	AnchorTx      hexutil.Bytes `json:"anchorTx,omitempty"`
+	AnchorReceipt hexutil.Bytes `json:"anchorReceipt,omitempty"`
+	// Invalid block proof only.
+	TargetMeta        hexutil.Bytes `json:"targetMeta,omitempty"`
+	InvalidateReceipt hexutil.Bytes `json:"invalidateReceipt,omitempty"`
+	// Merkle proofs of the anchor transaction and its receipt, or of the invalidate
+	// transaction's receipt.
+	MerkleProofs []hexutil.Bytes `json:"merkleProofs"`
+	CreatedAt    time.Time       `json:"createdAt"`
+}
+
+// newProofArtifact creates a new proof artifact from the encoded TaikoL1.proveBlock /
+// TaikoL1.proveBlockInvalid transaction inputs.
+func newProofArtifact(
+	blockID *big.Int,
+	blockHash common.Hash,
+	isValidProof bool,
+	inputs [][]byte,
+	merkleProofs ...[]byte,
+) *proofArtifact {
+	artifact := &proofArtifact{
+		BlockID:      blockID.Uint64(),
+		BlockHash:    blockHash,
+		IsValidProof: isValidProof,
+		Evidence:     inputs[0],
+		CreatedAt:    time.Now().UTC(),
+	}
+
+	if isValidProof {
+		artifact.AnchorTx, artifact.AnchorReceipt = inputs[1], inputs[2]
+	} else {
+		artifact.TargetMeta, artifact.InvalidateReceipt = inputs[1], inputs[2]
+	}
+
+	for _, proof := range merkleProofs {
+		artifact.MerkleProofs = append(artifact.MerkleProofs, proof)
+	}
+
+	return artifact
+}
+
+// inputs returns the encoded TaikoL1.proveBlock / TaikoL1.proveBlockInvalid transaction inputs.
+func (a *proofArtifact) inputs() [][]byte {
+	if a.IsValidProof {
+		return [][]byte{a.Evidence, a.AnchorTx, a.AnchorReceipt}
+	}
+
+	return [][]byte{a.Evidence, a.TargetMeta, a.InvalidateReceipt}
+}
+
+// verifyMerkleProofs verifies the merkle proofs inside the evidence against the roots of the
+// evidence's block header.
+func (a *proofArtifact) verifyMerkleProofs() error {
+	evidence, err := encoding.DecodeEvidence(a.Evidence)
+	if err != nil {
+		return fmt.Errorf("failed to decode evidence: %w", err)
+	}
+
+	proofs := evidence.Proofs
+	if a.IsValidProof {
+		if len(proofs) < 2 {
+			return fmt.Errorf("invalid evidence proofs length: %d", len(proofs))
+		}
+		if _, err := verifyTrieProof(evidence.Header.TransactionsRoot, 0, proofs[len(proofs)-2]); err != nil {
+			return fmt.Errorf("invalid anchor transaction proof: %w", err)
+		}
+		if _, err := verifyTrieProof(evidence.Header.ReceiptsRoot, 0, proofs[len(proofs)-1]); err != nil {
+			return fmt.Errorf("invalid anchor receipt proof: %w", err)
+		}
+		return nil
+	}
+
+	if len(proofs) < 1 {
+		return fmt.Errorf("invalid evidence proofs length: %d", len(proofs))
+	}
+	if _, err := verifyTrieProof(evidence.Header.ReceiptsRoot, 0, proofs[len(proofs)-1]); err != nil {
+		return fmt.Errorf("invalid receipt proof: %w", err)
+	}
+
+	return nil
+}
+
+// fileName returns the name of the file to save the artifact.
+func (a *proofArtifact) fileName() string {
+	if a.IsValidProof {
+		return fmt.Sprintf("%d-valid.json", a.BlockID)
+	}
+
+	return fmt.Sprintf("%d-invalid.json", a.BlockID)
+}
+
+// saveProofArtifact saves the given artifact to the given directory, and returns the file path.
+func saveProofArtifact(dir string, artifact *proofArtifact) (string, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return "", fmt.Errorf("failed to create proof artifacts directory: %w", err)
+	}
+
+	data, err := json.MarshalIndent(artifact, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to encode proof artifact: %w", err)
+	}
+
+	path := filepath.Join(dir, artifact.fileName())
+	if err := fileUtil.WriteFileAtomic(path, data, 0600); err != nil {
+		return "", fmt.Errorf("failed to write proof artifact file: %w", err)
+	}
+
+	return path, nil
+}
+
+// loadProofArtifact loads the artifact from the given file.
+func loadProofArtifact(path string) (*proofArtifact, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read proof artifact file: %w", err)
+	}
+
+	artifact := new(proofArtifact)
+	if err := json.Unmarshal(data, artifact); err != nil {
+		return nil, fmt.Errorf("failed to decode proof artifact file: %w", err)
+	}
+
+	if len(artifact.Evidence) == 0 {
+		return nil, fmt.Errorf("invalid proof artifact file without evidence: %s", path)
+	}
+
+	return artifact, nil
+}
+
+// saveProofArtifact saves the given artifact to the configured directory, if there is one.
+func (p *Prover) saveProofArtifact(artifact *proofArtifact) {
+	if len(p.cfg.ProofArtifactsDir) == 0 {
+		return
+	}
+
+	path, err := saveProofArtifact(p.cfg.ProofArtifactsDir, artifact)
+	if err != nil {
+		log.Warn("Failed to save proof artifact", "blockID", artifact.BlockID, "error", err)
+		return
+	}
+
+	log.Debug("Proof artifact saved", "blockID", artifact.BlockID, "path", path)
+}
+
+// ProofSubmitter re-sends a saved proof with fresh gas parameters, then exits.
+type ProofSubmitter struct {
+	*Prover
+	artifact *proofArtifact
+}
+
+// InitFromCli initializes the given proof submitter instance based on the command line flags.
+func (s *ProofSubmitter) InitFromCli(ctx context.Context, c *cli.Context) (err error) {
+	cfg, err := NewConfigFromCliContext(c)
+	if err != nil {
+		return err
+	}
+
+	if s.artifact, err = loadProofArtifact(c.String(flags.ProofArtifactFile.Name)); err != nil {
+		return err
+	}
+
+	s.Prover = &Prover{cfg: cfg, ctx: ctx}
+	s.proverAddress = crypto.PubkeyToAddress(cfg.L1ProverPrivKey.PublicKey)
+	if s.rpc, err = rpc.NewClient(ctx, &rpc.ClientConfig{
+		L1Endpoint:            cfg.L1Endpoint,
+		L1FallbackEndpoints:   cfg.L1FallbackEndpoints,
+		L1HealthCheckInterval: cfg.L1HealthCheckInterval,
+		L1MaxHeadLag:          cfg.L1MaxHeadLag,
+		L1RateLimit:           cfg.L1RateLimit,
+		L1LogsRateLimit:       cfg.L1LogsRateLimit,
+		L2RateLimit:           cfg.L2RateLimit,
+		L2LogsRateLimit:       cfg.L2LogsRateLimit,
+		L2Endpoint:            cfg.L2Endpoint,
+		TaikoL1Address:        cfg.TaikoL1Address,
+		TaikoL2Address:        cfg.TaikoL2Address,
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Run re-sends the saved proof, and waits for the transaction to be executed.
+func (s *ProofSubmitter) Run() error {
+	// Verify the merkle proofs locally, so that malformed evidence won't be submitted to L1.
+	if err := s.artifact.verifyMerkleProofs(); err != nil {
+		return err
+	}
+
+	txOpts, err := s.getProveBlocksTxOpts(s.ctx, s.rpc.L1)
+	if err != nil {
+		return err
+	}
+
+	var (
+		blockID = new(big.Int).SetUint64(s.artifact.BlockID)
+		tx      *types.Transaction
+	)
+	if s.artifact.IsValidProof {
+		tx, err = s.rpc.TaikoL1.ProveBlock(txOpts, blockID, s.artifact.inputs())
+	} else {
+		tx, err = s.rpc.TaikoL1.ProveBlockInvalid(txOpts, blockID, s.artifact.inputs())
+	}
+	if err != nil {
+		return fmt.Errorf("failed to send proof submission transaction: %w", err)
+	}
+
+	log.Info("Proof submission transaction sent", "blockID", blockID, "txHash", tx.Hash())
+
+	if _, err := rpc.WaitReceipt(s.ctx, s.rpc.L1, tx); err != nil {
+		return fmt.Errorf("failed to wait till proof submission transaction executed: %w", err)
+	}
+
+	log.Info("✅ Saved proof submitted", "blockID", blockID, "isValidProof", s.artifact.IsValidProof)
+
+	return nil
+}
+
+// Close closes the proof submitter instance.
+func (s *ProofSubmitter) Close() {}
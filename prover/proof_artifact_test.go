@@ -0,0 +1,43 @@
+package prover
+
+import (
+	"math/big"
+	"os"
+	"path/filepath"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func (s *ProverTestSuite) TestSaveAndLoadProofArtifact() {
+	dir := filepath.Join(s.T().TempDir(), "artifacts")
+	inputs := [][]byte{{0x01}, {0x02}, {0x03}}
+
+	for _, isValidProof := range []bool{true, false} {
+		artifact := newProofArtifact(
+			big.NewInt(1),
+			common.HexToHash("0x1234"),
+			isValidProof,
+			inputs,
+			[]byte{0x04},
+			[]byte{0x05},
+		)
+
+		path, err := saveProofArtifact(dir, artifact)
+		s.Nil(err)
+		s.Equal(filepath.Join(dir, artifact.fileName()), path)
+
+		loaded, err := loadProofArtifact(path)
+		s.Nil(err)
+		s.Equal(uint64(1), loaded.BlockID)
+		s.Equal(common.HexToHash("0x1234"), loaded.BlockHash)
+		s.Equal(isValidProof, loaded.IsValidProof)
+		s.Equal(inputs, loaded.inputs())
+		s.Len(loaded.MerkleProofs, 2)
+	}
+
+	// Artifacts without evidence should be rejected.
+	path := filepath.Join(dir, "empty.json")
+	s.Nil(os.WriteFile(path, []byte("{}"), 0600))
+	_, err := loadProofArtifact(path)
+	s.NotNil(err)
+}
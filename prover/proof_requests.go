@@ -0,0 +1,161 @@
+package prover
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/taikoxyz/taiko-client/metrics"
+	"github.com/taikoxyz/taiko-client/prover/producer"
+)
+
+// onProofRequested tracks the given proof request and marks its proving job as proving, it is called
+// by the proof producer before the proof generation starts.
+func (p *Prover) onProofRequested(handle *producer.ProofRequestHandle) {
+	p.trackProofRequest(handle)
+	p.setProvingJobStatus(handle.BlockID, provingJobProving)
+}
+
+// trackProofRequest records the given in-flight proof request, and starts watching it. If there is
+// already an in-flight request for the same block, it will be cancelled to avoid generating the same
+// proof twice.
+func (p *Prover) trackProofRequest(handle *producer.ProofRequestHandle) {
+	p.proofRequestsMutex.Lock()
+	defer p.proofRequestsMutex.Unlock()
+
+	if previous, ok := p.proofRequests[handle.BlockID.Uint64()]; ok {
+		previous.Cancel()
+	}
+
+	p.proofRequests[handle.BlockID.Uint64()] = handle
+
+	go p.watchProofRequest(handle)
+}
+
+// watchProofRequest waits for the given proof request to finish, and marks the corresponding
+// proving task as failed if no proof is generated.
+func (p *Prover) watchProofRequest(handle *producer.ProofRequestHandle) {
+	select {
+	case <-p.ctx.Done():
+		return
+	case <-handle.Done():
+	}
+
+	// The generated proof has been sent to the result channel, or the request has been cancelled
+	// on purpose, in both cases the proving task will be handled by others.
+	if handle.Err() == nil || handle.Cancelled() {
+		return
+	}
+
+	p.proofRequestsMutex.Lock()
+	if p.proofRequests[handle.BlockID.Uint64()] == handle {
+		delete(p.proofRequests, handle.BlockID.Uint64())
+	}
+	p.proofRequestsMutex.Unlock()
+
+	p.recordProvingJobError(handle.BlockID, handle.Err())
+	p.provingScheduler.fail(handle.BlockID.Uint64(), handle.Err())
+}
+
+// untrackProofRequest removes the in-flight proof request of the given block.
+func (p *Prover) untrackProofRequest(blockID *big.Int) {
+	p.proofRequestsMutex.Lock()
+	defer p.proofRequestsMutex.Unlock()
+
+	delete(p.proofRequests, blockID.Uint64())
+}
+
+// cancelProofRequests cancels all in-flight proof requests whose block IDs match the given filter,
+// and returns the number of cancelled requests. If requeue is true, the corresponding proving tasks
+// will be re-queued to generate new proofs, otherwise they will be marked as finished.
+func (p *Prover) cancelProofRequests(filter func(blockID *big.Int) bool, reason string, requeue bool) int {
+	// Take a snapshot at first, since the filter might send RPC requests.
+	p.proofRequestsMutex.Lock()
+	handles := make([]*producer.ProofRequestHandle, 0, len(p.proofRequests))
+	for _, handle := range p.proofRequests {
+		handles = append(handles, handle)
+	}
+	p.proofRequestsMutex.Unlock()
+
+	var cancelled int
+	for _, handle := range handles {
+		if !filter(handle.BlockID) {
+			continue
+		}
+
+		p.proofRequestsMutex.Lock()
+		if p.proofRequests[handle.BlockID.Uint64()] == handle {
+			delete(p.proofRequests, handle.BlockID.Uint64())
+		}
+		p.proofRequestsMutex.Unlock()
+
+		log.Info("Cancel proof request", "blockID", handle.BlockID, "reason", reason)
+
+		handle.Cancel()
+		err := fmt.Errorf("proof request cancelled: %s", reason)
+		p.recordProvingJobError(handle.BlockID, err)
+		if requeue {
+			p.provingScheduler.fail(handle.BlockID.Uint64(), err)
+		} else {
+			p.provingScheduler.finish(handle.BlockID.Uint64())
+		}
+		metrics.ProverCancelledProofCounter.Inc(1)
+		cancelled++
+	}
+
+	return cancelled
+}
+
+// cancelVerifiedProofRequests cancels all in-flight proof requests whose blocks have been verified.
+func (p *Prover) cancelVerifiedProofRequests(latestVerifiedID *big.Int) {
+	p.cancelProofRequests(func(blockID *big.Int) bool {
+		return blockID.Cmp(latestVerifiedID) <= 0
+	}, "block verified", false)
+}
+
+// cancelSaturatedProofRequests cancels all in-flight proof requests whose blocks' fork choices have
+// already reached the maximum number of proofs.
+func (p *Prover) cancelSaturatedProofRequests() {
+	p.cancelProofRequests(func(blockID *big.Int) bool {
+		saturated, err := p.isForkChoiceSaturated(blockID)
+		if err != nil {
+			log.Warn("Failed to check whether the fork choice is saturated", "blockID", blockID, "error", err)
+			return false
+		}
+
+		return saturated
+	}, "fork choice saturated", false)
+}
+
+// isForkChoiceSaturated checks whether the fork choice of the given block has already reached
+// the maximum number of proofs.
+func (p *Prover) isForkChoiceSaturated(id *big.Int) (bool, error) {
+	parentHash, err := p.getParentHash(id)
+	if err != nil {
+		return false, err
+	}
+
+	provers, err := p.rpc.TaikoL1.GetBlockProvers(nil, id, parentHash)
+	if err != nil {
+		return false, err
+	}
+
+	return uint64(len(provers)) >= p.protocolConstants.MaxProofsPerForkChoice.Uint64(), nil
+}
+
+// updateProvingJobsGauges updates the gauges of the in-flight proving jobs, and the age of the oldest
+// proposed block which has not been proven by current prover yet.
+func (p *Prover) updateProvingJobsGauges() {
+	running, oldest := p.provingScheduler.inFlight()
+	metrics.ProverInFlightProvingJobsGauge.Update(int64(running))
+
+	if oldest == nil {
+		metrics.ProverOldestUnprovenBlockAgeGauge.Update(0)
+		return
+	}
+
+	metrics.ProverOldestUnprovenBlockAgeGauge.Update(
+		int64(time.Since(time.Unix(int64(oldest.Meta.Timestamp), 0)).Seconds()),
+	)
+}
@@ -0,0 +1,293 @@
+package prover
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"text/tabwriter"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/taikoxyz/taiko-client/bindings"
+	"github.com/taikoxyz/taiko-client/cmd/flags"
+	eventIterator "github.com/taikoxyz/taiko-client/pkg/chain_iterator/event_iterator"
+	rpcErrors "github.com/taikoxyz/taiko-client/pkg/rpc_errors"
+	"github.com/taikoxyz/taiko-client/prover/producer"
+	"github.com/urfave/cli/v2"
+)
+
+// All results of proving a block in the range.
+const (
+	rangeProvingProven  = "proven"
+	rangeProvingSkipped = "skipped"
+	rangeProvingFailed  = "failed"
+)
+
+// rangeProvingResult represents the result of proving a block in the range.
+type rangeProvingResult struct {
+	BlockID      uint64
+	Result       string
+	IsValidProof bool
+	Err          error
+}
+
+// RangeProver proves exactly the blocks in the given range once, then exits, which is useful
+// for re-proving some specific blocks.
+type RangeProver struct {
+	*Prover
+	fromID uint64
+	toID   uint64
+}
+
+// InitFromCli initializes the given range prover instance based on the command line flags.
+func (p *RangeProver) InitFromCli(ctx context.Context, c *cli.Context) error {
+	cfg, err := NewConfigFromCliContext(c)
+	if err != nil {
+		return err
+	}
+
+	p.fromID = c.Uint64(flags.ProveFromBlockID.Name)
+	p.toID = c.Uint64(flags.ProveToBlockID.Name)
+	if p.fromID == 0 || p.fromID > p.toID {
+		return fmt.Errorf("invalid block range to prove: [%d, %d]", p.fromID, p.toID)
+	}
+
+	p.Prover = new(Prover)
+	return InitFromConfig(ctx, p.Prover, cfg)
+}
+
+// Run proves all blocks in the range, prints a summary, and returns an error if any block
+// fails to be proven.
+func (p *RangeProver) Run() error {
+	results, err := p.proveRange(p.ctx, p.fromID, p.toID)
+	if err != nil {
+		return err
+	}
+
+	printRangeProvingResults(results)
+
+	var failed int
+	for _, result := range results {
+		if result.Result == rangeProvingFailed {
+			failed++
+		}
+	}
+
+	if failed != 0 {
+		return fmt.Errorf("failed to prove %d of %d blocks", failed, len(results))
+	}
+
+	return nil
+}
+
+// Close closes the range prover instance.
+func (p *RangeProver) Close() {
+	p.wg.Wait()
+}
+
+// proveRange proves the blocks in the given range one by one.
+func (p *RangeProver) proveRange(ctx context.Context, fromID, toID uint64) ([]*rangeProvingResult, error) {
+	events, err := p.getBlockProposedEvents(ctx, fromID, toID)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*rangeProvingResult, 0, toID-fromID+1)
+	for id := fromID; id <= toID; id++ {
+		event, ok := events[id]
+		if !ok {
+			results = append(results, &rangeProvingResult{
+				BlockID: id,
+				Result:  rangeProvingFailed,
+				Err:     errors.New("BlockProposed event not found"),
+			})
+			continue
+		}
+
+		result := p.proveBlockOnce(ctx, event)
+		if result.Err != nil {
+			log.Error("Failed to prove block", "blockID", id, "error", result.Err)
+		}
+		results = append(results, result)
+
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+	}
+
+	return results, nil
+}
+
+// getBlockProposedEvents fetches the BlockProposed events of the blocks in the given range.
+func (p *Prover) getBlockProposedEvents(
+	ctx context.Context,
+	fromID uint64,
+	toID uint64,
+) (map[uint64]*bindings.TaikoL1ClientBlockProposed, error) {
+	stateVars, err := p.rpc.GetProtocolStateVariables(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if toID >= stateVars.NextBlockID {
+		return nil, fmt.Errorf("block %d has not been proposed yet, next block ID: %d", toID, stateVars.NextBlockID)
+	}
+
+	// Proposals are ordered by block ID on L1, so the events can be searched between the L1 heights of
+	// the previous block's and the last block's proposals, if they are known by the L2 execution engine.
+	startHeight := new(big.Int).SetUint64(stateVars.GenesisHeight)
+	if fromID > 1 {
+		l1Origin, err := p.rpc.L2.L1OriginByID(ctx, new(big.Int).SetUint64(fromID-1))
+		if err != nil && !rpcErrors.IsNotFound(err) {
+			return nil, fmt.Errorf("failed to fetch block %d's L1 origin: %w", fromID-1, err)
+		}
+		if l1Origin != nil {
+			startHeight = l1Origin.L1BlockHeight
+		}
+	}
+
+	var endHeight *big.Int
+	l1Origin, err := p.rpc.L2.L1OriginByID(ctx, new(big.Int).SetUint64(toID))
+	if err != nil && !rpcErrors.IsNotFound(err) {
+		return nil, fmt.Errorf("failed to fetch block %d's L1 origin: %w", toID, err)
+	}
+	if l1Origin != nil {
+		endHeight = l1Origin.L1BlockHeight
+	}
+
+	ids := make([]*big.Int, 0, toID-fromID+1)
+	for id := fromID; id <= toID; id++ {
+		ids = append(ids, new(big.Int).SetUint64(id))
+	}
+
+	events := make(map[uint64]*bindings.TaikoL1ClientBlockProposed)
+	iter, err := eventIterator.NewBlockProposedIterator(ctx, &eventIterator.BlockProposedIteratorConfig{
+		Client:      p.rpc.L1,
+		TaikoL1:     p.rpc.TaikoL1,
+		StartHeight: startHeight,
+		EndHeight:   endHeight,
+		FilterQuery: ids,
+		OnBlockProposedEvent: func(
+			_ context.Context,
+			event *bindings.TaikoL1ClientBlockProposed,
+			end eventIterator.EndBlockProposedEventIterFunc,
+		) error {
+			events[event.Id.Uint64()] = event
+			if uint64(len(events)) == toID-fromID+1 {
+				end()
+			}
+			return nil
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := iter.Iter(); err != nil {
+		return nil, fmt.Errorf("failed to iterate BlockProposed events: %w", err)
+	}
+
+	return events, nil
+}
+
+// proveBlockOnce generates the proof of the given proposed block, then submits it and waits
+// for the submission result.
+func (p *RangeProver) proveBlockOnce(
+	ctx context.Context,
+	event *bindings.TaikoL1ClientBlockProposed,
+) *rangeProvingResult {
+	result := &rangeProvingResult{BlockID: event.Id.Uint64()}
+
+	finished, err := p.proveBlock(ctx, event)
+	if err != nil {
+		result.Result, result.Err = rangeProvingFailed, err
+		return result
+	}
+
+	// The block has been verified, proven by current prover, or its fork choice has been saturated.
+	if finished {
+		result.Result = rangeProvingSkipped
+		return result
+	}
+
+	proofWithHeader, isValidProof, err := p.waitProof(ctx, event.Id)
+	if err != nil {
+		result.Result, result.Err = rangeProvingFailed, err
+		return result
+	}
+	result.IsValidProof = isValidProof
+
+	p.onProofReady(proofWithHeader, isValidProof)
+
+	if isValidProof {
+		err = p.submitValidBlockProof(ctx, proofWithHeader)
+	} else {
+		err = p.submitInvalidBlockProof(ctx, proofWithHeader)
+	}
+	if err != nil {
+		result.Result, result.Err = rangeProvingFailed, err
+		return result
+	}
+
+	result.Result = rangeProvingProven
+	return result
+}
+
+// waitProof waits for the proof of the given block to be generated, returns whether it is
+// a valid block proof.
+func (p *RangeProver) waitProof(ctx context.Context, blockID *big.Int) (*producer.ProofWithHeader, bool, error) {
+	// Resumed jobs have no in-flight proof requests, and their proofs have already been sent.
+	var requestDone <-chan struct{}
+	p.proofRequestsMutex.Lock()
+	handle, ok := p.proofRequests[blockID.Uint64()]
+	p.proofRequestsMutex.Unlock()
+	if ok {
+		requestDone = handle.Done()
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, false, ctx.Err()
+		case proofWithHeader := <-p.proveValidProofCh:
+			if proofWithHeader.BlockID.Cmp(blockID) == 0 {
+				return proofWithHeader, true, nil
+			}
+		case proofWithHeader := <-p.proveInvalidProofCh:
+			if proofWithHeader.BlockID.Cmp(blockID) == 0 {
+				return proofWithHeader, false, nil
+			}
+		case <-requestDone:
+			if handle.Err() != nil {
+				return nil, false, fmt.Errorf("failed to generate proof: %w", handle.Err())
+			}
+			// The proof has been sent to the result channel.
+			requestDone = nil
+		}
+	}
+}
+
+// printRangeProvingResults prints a summary of the given results to the standard output.
+func printRangeProvingResults(results []*rangeProvingResult) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	defer w.Flush()
+
+	fmt.Fprintln(w, "BLOCK ID\tRESULT\tPROOF\tERROR")
+	for _, result := range results {
+		proofType := "-"
+		if result.Result == rangeProvingProven {
+			proofType = "invalid"
+			if result.IsValidProof {
+				proofType = "valid"
+			}
+		}
+
+		errMsg := "-"
+		if result.Err != nil {
+			errMsg = result.Err.Error()
+		}
+
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\n", result.BlockID, result.Result, proofType, errMsg)
+	}
+}
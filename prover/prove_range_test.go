@@ -0,0 +1,30 @@
+package prover
+
+import (
+	"context"
+	"os"
+
+	"github.com/taikoxyz/taiko-client/cmd/flags"
+	"github.com/urfave/cli/v2"
+)
+
+func (s *ProverTestSuite) TestRangeProverInvalidRange() {
+	app := cli.NewApp()
+	app.Flags = []cli.Flag{
+		&cli.StringFlag{Name: flags.L1ProverPrivKey.Name},
+		&cli.Uint64Flag{Name: flags.ProveFromBlockID.Name},
+		&cli.Uint64Flag{Name: flags.ProveToBlockID.Name},
+	}
+	app.Action = func(ctx *cli.Context) error {
+		return new(RangeProver).InitFromCli(context.Background(), ctx)
+	}
+
+	for _, blockRange := range [][2]string{{"0", "1"}, {"3", "2"}} {
+		s.ErrorContains(app.Run([]string{
+			"TestRangeProverInvalidRange",
+			"-" + flags.L1ProverPrivKey.Name, os.Getenv("L1_PROVER_PRIVATE_KEY"),
+			"-" + flags.ProveFromBlockID.Name, blockRange[0],
+			"-" + flags.ProveToBlockID.Name, blockRange[1],
+		}), "invalid block range to prove")
+	}
+}
@@ -2,22 +2,22 @@ package prover
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math/big"
-	"strings"
 	"sync"
 	"time"
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
-	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/ethereum/go-ethereum/event"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/taikoxyz/taiko-client/bindings"
 	"github.com/taikoxyz/taiko-client/metrics"
 	eventIterator "github.com/taikoxyz/taiko-client/pkg/chain_iterator/event_iterator"
 	"github.com/taikoxyz/taiko-client/pkg/rpc"
+	rpcErrors "github.com/taikoxyz/taiko-client/pkg/rpc_errors"
 	txListValidator "github.com/taikoxyz/taiko-client/pkg/tx_list_validator"
 	"github.com/taikoxyz/taiko-client/prover/producer"
 	"github.com/urfave/cli/v2"
@@ -40,6 +40,9 @@ type Prover struct {
 	latestVerifiedL1Height uint64
 	lastHandledBlockID     uint64
 	l1Current              uint64
+	l1CurrentHash          common.Hash
+	intakePaused           bool
+	cursorMutex            sync.Mutex
 
 	// Subscriptions
 	blockProposedCh  chan *bindings.TaikoL1ClientBlockProposed
@@ -49,12 +52,18 @@ type Prover struct {
 	proveNotify      chan struct{}
 
 	// Proof related
+	blockSelection      *blockSelectionPolicy
 	proveValidProofCh   chan *producer.ProofWithHeader
 	proveInvalidProofCh chan *producer.ProofWithHeader
 	proofProducer       producer.ProofProducer
+	provingJobs         *provingJobStore
+	proofRequests       map[uint64]*producer.ProofRequestHandle
+	proofRequestsMutex  sync.Mutex
+
+	// Proving scheduler
+	provingScheduler *provingScheduler
 
 	// Concurrency guards
-	proposeConcurrencyGuard     chan struct{}
 	submitProofConcurrencyGuard chan struct{}
 	submitProofTxMutex          sync.Mutex
 
@@ -79,10 +88,17 @@ func InitFromConfig(ctx context.Context, p *Prover, cfg *Config) (err error) {
 
 	// Clients
 	if p.rpc, err = rpc.NewClient(p.ctx, &rpc.ClientConfig{
-		L1Endpoint:     cfg.L1Endpoint,
-		L2Endpoint:     cfg.L2Endpoint,
-		TaikoL1Address: cfg.TaikoL1Address,
-		TaikoL2Address: cfg.TaikoL2Address,
+		L1Endpoint:            cfg.L1Endpoint,
+		L1FallbackEndpoints:   cfg.L1FallbackEndpoints,
+		L1HealthCheckInterval: cfg.L1HealthCheckInterval,
+		L1MaxHeadLag:          cfg.L1MaxHeadLag,
+		L1RateLimit:           cfg.L1RateLimit,
+		L1LogsRateLimit:       cfg.L1LogsRateLimit,
+		L2RateLimit:           cfg.L2RateLimit,
+		L2LogsRateLimit:       cfg.L2LogsRateLimit,
+		L2Endpoint:            cfg.L2Endpoint,
+		TaikoL1Address:        cfg.TaikoL1Address,
+		TaikoL2Address:        cfg.TaikoL2Address,
 	}); err != nil {
 		return err
 	}
@@ -116,12 +132,30 @@ func InitFromConfig(ctx context.Context, p *Prover, cfg *Config) (err error) {
 	p.proveValidProofCh = make(chan *producer.ProofWithHeader, p.protocolConstants.MaxNumBlocks.Uint64())
 	p.proveInvalidProofCh = make(chan *producer.ProofWithHeader, p.protocolConstants.MaxNumBlocks.Uint64())
 	p.proveNotify = make(chan struct{}, 1)
+	p.proofRequests = make(map[uint64]*producer.ProofRequestHandle)
+	p.blockSelection = &blockSelectionPolicy{
+		minAge:     cfg.ProveBlocksMinAge,
+		shardIndex: cfg.ProveBlocksShardIndex,
+		shardTotal: cfg.ProveBlocksShardTotal,
+	}
+	if p.provingJobs, err = newProvingJobStore(cfg.ProvingJobsStateFile); err != nil {
+		return err
+	}
 	if err := p.initL1Current(cfg.StartingBlockID); err != nil {
 		return fmt.Errorf("initialize L1 current cursor error: %w", err)
 	}
 
+	p.provingScheduler = newProvingScheduler(
+		uint64(cfg.MaxConcurrentProvingJobs),
+		p.runProvingTask,
+		func() uint64 {
+			_, lastHandledBlockID := p.cursor()
+			return lastHandledBlockID
+		},
+		p.onProvingCursorAdvanced,
+	)
+
 	// Concurrency guards
-	p.proposeConcurrencyGuard = make(chan struct{}, cfg.MaxConcurrentProvingJobs)
 	p.submitProofConcurrencyGuard = make(chan struct{}, cfg.MaxConcurrentProvingJobs)
 
 	if cfg.Dummy {
@@ -129,8 +163,19 @@ func InitFromConfig(ctx context.Context, p *Prover, cfg *Config) (err error) {
 			RandomDummyProofDelayLowerBound: p.cfg.RandomDummyProofDelayLowerBound,
 			RandomDummyProofDelayUpperBound: p.cfg.RandomDummyProofDelayUpperBound,
 		}
+	} else if len(cfg.ZKEvmRpcdEndpoints) == 1 {
+		if p.proofProducer, err = producer.NewZkevmRpcdProducer(
+			cfg.ZKEvmRpcdEndpoints[0],
+			cfg.ZkEvmRpcdProofTimeout,
+		); err != nil {
+			return err
+		}
 	} else {
-		if p.proofProducer, err = producer.NewZkevmRpcdProducer(cfg.ZKEvmRpcdEndpoint); err != nil {
+		if p.proofProducer, err = producer.NewZkevmRpcdPoolProducer(
+			p.ctx,
+			cfg.ZKEvmRpcdEndpoints,
+			cfg.ZkEvmRpcdProofTimeout,
+		); err != nil {
 			return err
 		}
 	}
@@ -140,9 +185,24 @@ func InitFromConfig(ctx context.Context, p *Prover, cfg *Config) (err error) {
 
 // Start starts the main loop of the L2 block prover.
 func (p *Prover) Start() error {
-	p.wg.Add(1)
+	p.wg.Add(2)
 	p.startSubscription()
 	go p.eventLoop()
+	go func() {
+		defer p.wg.Done()
+		p.provingScheduler.loop(p.ctx)
+	}()
+
+	if len(p.cfg.AdminAPIAddr) != 0 {
+		if err := p.serveAdminAPI(); err != nil {
+			return err
+		}
+	}
+
+	if p.cfg.VerifyBlocks {
+		p.wg.Add(1)
+		go p.verifyBlocksLoop()
+	}
 
 	return nil
 }
@@ -190,6 +250,12 @@ func (p *Prover) eventLoop() {
 				log.Error("Handle BlockVerified event error", "error", err)
 			}
 		case <-forceProvingTicker.C:
+			p.cancelSaturatedProofRequests()
+			p.cancelReorgedProofRequests()
+			p.updateProvingJobsGauges()
+			if err := p.rewindReorgedL1Current(p.ctx); err != nil {
+				log.Error("Rewind reorged L1Current cursor error", "error", err)
+			}
 			reqProving()
 		}
 	}
@@ -214,10 +280,11 @@ func (p *Prover) proveOp() error {
 		return nil
 	}
 
+	l1Current, _ := p.cursor()
 	iter, err := eventIterator.NewBlockProposedIterator(p.ctx, &eventIterator.BlockProposedIteratorConfig{
 		Client:               p.rpc.L1,
 		TaikoL1:              p.rpc.TaikoL1,
-		StartHeight:          new(big.Int).SetUint64(p.l1Current),
+		StartHeight:          new(big.Int).SetUint64(l1Current),
 		OnBlockProposedEvent: p.onBlockProposed,
 	})
 	if err != nil {
@@ -227,83 +294,200 @@ func (p *Prover) proveOp() error {
 	return iter.Iter()
 }
 
-// onBlockProposed tries to prove that the newly proposed block is valid/invalid.
+// onBlockProposed schedules a proving task for the newly proposed block, if it should be proven
+// by current prover.
 func (p *Prover) onBlockProposed(
 	ctx context.Context,
 	event *bindings.TaikoL1ClientBlockProposed,
 	end eventIterator.EndBlockProposedEventIterFunc,
 ) error {
-	// If there is newly generated proofs, we need to submit them as soon as possible.
-	if len(p.proveValidProofCh) > 0 || len(p.proveInvalidProofCh) > 0 {
+	_, lastHandledBlockID := p.cursor()
+	if event.Id.Uint64() <= lastHandledBlockID || p.provingScheduler.has(event.Id.Uint64()) {
+		return nil
+	}
+
+	// No more new blocks will be scheduled, until the intake is resumed.
+	if p.isIntakePaused() {
 		end()
 		return nil
 	}
-	if event.Id.Uint64() <= p.lastHandledBlockID {
+
+	// Check whether the block should be proven by current prover.
+	selection, reason := p.blockSelection.selectBlock(event.Id, event.Meta.Timestamp, time.Now())
+	switch selection {
+	case blockSkipped:
+		log.Debug("Skip proposed block", "blockID", event.Id, "reason", reason)
+		p.provingScheduler.skip(event)
+		return nil
+	case blockPostponed:
+		// Blocks are iterated in order, so all the following blocks are not selectable either.
+		log.Debug("Postpone proving proposed block", "blockID", event.Id, "reason", reason)
+		end()
 		return nil
 	}
+
 	log.Info("Proposed block", "blockID", event.Id)
 	metrics.ProverReceivedProposedBlockGauge.Update(event.Id.Int64())
 
-	handleBlockProposedEvent := func() error {
-		defer func() { <-p.proposeConcurrencyGuard }()
+	p.provingScheduler.push(event)
 
-		// Check whether the block has been verified.
-		isVerified, err := p.isBlockVerified(event.Id)
-		if err != nil {
-			return err
-		}
+	return nil
+}
 
-		if isVerified {
-			log.Info("📋 Block has been verified", "blockID", event.Id)
-			return nil
-		}
+// runProvingTask runs the scheduled proving task of the given proposed block, the task will be marked as
+// finished once the block is proven or verified, or as failed if any error occurs, so it can be retried.
+func (p *Prover) runProvingTask(event *bindings.TaikoL1ClientBlockProposed) {
+	// The BlockProposed event might have been reorged out since it was scheduled.
+	refreshed, err := p.refreshProposedEvent(p.ctx, event)
+	if err != nil {
+		log.Error("Refresh BlockProposed event error", "blockID", event.Id, "error", err)
+		p.recordProvingJobError(event.Id, err)
+		p.provingScheduler.fail(event.Id.Uint64(), err)
+		return
+	}
+	event = refreshed
 
-		isProven, err := p.isProvenByCurrentProver(event.Id)
-		if err != nil {
-			return fmt.Errorf("failed to check whether the L2 block has been proven by current prover: %w", err)
-		}
+	finished, err := p.proveBlock(p.ctx, event)
+	if err != nil {
+		log.Error("Handle new BlockProposed event error", "blockID", event.Id, "error", err)
+		p.recordProvingJobError(event.Id, err)
+		p.provingScheduler.fail(event.Id.Uint64(), err)
+		return
+	}
 
-		if isProven {
-			log.Info("📬 Block's proof has already been submitted by current prover", "blockID", event.Id)
-			return nil
-		}
+	if finished {
+		p.provingScheduler.finish(event.Id.Uint64())
+	}
+}
 
-		// Check whether the transactions list is valid.
-		proposeBlockTx, err := p.rpc.L1.TransactionInBlock(ctx, event.Raw.BlockHash, event.Raw.TxIndex)
-		if err != nil {
-			return err
-		}
+// proveBlock tries to prove that the given proposed block is valid/invalid, returns true if there is
+// no need to prove the block, otherwise the proving task will be finished after the proof is submitted.
+func (p *Prover) proveBlock(ctx context.Context, event *bindings.TaikoL1ClientBlockProposed) (bool, error) {
+	if isProofUnneeded, err := p.isProofUnneeded(event.Id); err != nil || isProofUnneeded {
+		return isProofUnneeded, err
+	}
 
-		_, hint, invalidTxIndex, err := p.txListValidator.ValidateTxList(event.Id, proposeBlockTx.Data())
-		if err != nil {
-			return err
+	// Resume the job from the job store if its proof has already been generated from the same
+	// BlockProposed event, to avoid generating the same proof again.
+	if job, ok := p.provingJobs.get(event.Id.Uint64()); ok && job.Proof != nil &&
+		job.L1BlockHash == event.Raw.BlockHash &&
+		(job.Status == provingJobProofReady || job.Status == provingJobSubmitted) {
+		log.Info("📥 Resume proving job with generated proof", "blockID", event.Id, "status", job.Status)
+		if job.IsValidProof {
+			p.proveValidProofCh <- job.Proof
+		} else {
+			p.proveInvalidProofCh <- job.Proof
 		}
+		return false, nil
+	}
 
-		// Prove the proposed block is valid.
-		if hint == txListValidator.HintOK {
-			return p.proveBlockValid(ctx, event)
-		}
+	if err := p.provingJobs.enqueue(event.Id.Uint64(), event.Raw.BlockNumber, event.Raw.BlockHash); err != nil {
+		return false, err
+	}
+
+	// Check whether the transactions list is valid.
+	proposeBlockTx, err := p.rpc.L1.TransactionInBlock(ctx, event.Raw.BlockHash, event.Raw.TxIndex)
+	if err != nil {
+		return false, err
+	}
+
+	_, hint, invalidTxIndex, err := p.txListValidator.ValidateTxList(event.Id, proposeBlockTx.Data())
+	if err != nil {
+		return false, err
+	}
+
+	// Prove the proposed block is valid.
+	if hint == txListValidator.HintOK {
+		return false, p.proveBlockValid(ctx, event)
+	}
+
+	// Otherwise, prove the proposed block is invalid.
+	return false, p.proveBlockInvalid(ctx, event, hint, invalidTxIndex)
+}
+
+// isProofUnneeded checks whether there is nothing more to do for the given block, i.e. the block
+// has been verified, or proven by current prover, or its fork choice has been saturated.
+func (p *Prover) isProofUnneeded(blockID *big.Int) (bool, error) {
+	// Check whether the block has been verified.
+	isVerified, err := p.isBlockVerified(blockID)
+	if err != nil {
+		return false, err
+	}
+
+	if isVerified {
+		log.Info("📋 Block has been verified", "blockID", blockID)
+		return true, nil
+	}
 
-		// Otherwise, prove the proposed block is invalid.
-		return p.proveBlockInvalid(ctx, event, hint, invalidTxIndex)
+	isProven, err := p.isProvenByCurrentProver(blockID)
+	if err != nil {
+		return false, fmt.Errorf("failed to check whether the L2 block has been proven by current prover: %w", err)
+	}
+
+	if isProven {
+		log.Info("📬 Block's proof has already been submitted by current prover", "blockID", blockID)
+		return true, p.provingJobs.setStatus(blockID.Uint64(), provingJobConfirmed)
 	}
 
-	p.proposeConcurrencyGuard <- struct{}{}
+	// Check whether the block's fork choice has already reached the maximum number of proofs,
+	// in which case the proof of current prover will never be accepted.
+	isSaturated, err := p.isForkChoiceSaturated(blockID)
+	if err != nil {
+		return false, fmt.Errorf("failed to check whether the L2 block's fork choice is saturated: %w", err)
+	}
+
+	if isSaturated {
+		log.Info("🈵 Block's fork choice has already been saturated", "blockID", blockID)
+		return true, nil
+	}
+
+	return false, nil
+}
+
+// onProvingCursorAdvanced updates prover's cursors, after all blocks till the given one are
+// proven or verified.
+func (p *Prover) onProvingCursorAdvanced(event *bindings.TaikoL1ClientBlockProposed) {
+	p.cursorMutex.Lock()
+	defer p.cursorMutex.Unlock()
+
+	// Never move the cursor backwards.
+	if event.Id.Uint64() <= p.lastHandledBlockID {
+		return
+	}
 
 	p.l1Current = event.Raw.BlockNumber
+	p.l1CurrentHash = event.Raw.BlockHash
 	p.lastHandledBlockID = event.Id.Uint64()
+}
 
-	go func() {
-		if err := handleBlockProposedEvent(); err != nil {
-			log.Error("Handle new BlockProposed event error", "error", err)
-		}
-	}()
+// cursor returns prover's L1Current cursor and the last handled block ID.
+func (p *Prover) cursor() (uint64, uint64) {
+	p.cursorMutex.Lock()
+	defer p.cursorMutex.Unlock()
 
-	return nil
+	return p.l1Current, p.lastHandledBlockID
+}
+
+// isIntakePaused returns whether prover has stopped scheduling new proposed blocks.
+func (p *Prover) isIntakePaused() bool {
+	p.cursorMutex.Lock()
+	defer p.cursorMutex.Unlock()
+
+	return p.intakePaused
+}
+
+// setIntakePaused pauses or resumes scheduling new proposed blocks.
+func (p *Prover) setIntakePaused(paused bool) {
+	p.cursorMutex.Lock()
+	defer p.cursorMutex.Unlock()
+
+	p.intakePaused = paused
 }
 
 // submitProofOp performs a (valid block / invalid block) proof submission operation.
 func (p *Prover) submitProofOp(ctx context.Context, proofWithHeader *producer.ProofWithHeader, isValidProof bool) {
+	p.onProofReady(proofWithHeader, isValidProof)
+
 	p.submitProofConcurrencyGuard <- struct{}{}
 	go func() {
 		defer func() { <-p.submitProofConcurrencyGuard }()
@@ -317,15 +501,53 @@ func (p *Prover) submitProofOp(ctx context.Context, proofWithHeader *producer.Pr
 
 		if err != nil {
 			log.Error("Submit proof error", "isValidProof", isValidProof, "error", err)
+			p.recordProvingJobError(proofWithHeader.BlockID, err)
+
+			// The proof was rejected, only finish the task when there is nothing more to do,
+			// otherwise generate a new proof.
+			if errors.Is(err, errUnretryableProofSubmission) {
+				isProofUnneeded, checkErr := p.isProofUnneeded(proofWithHeader.BlockID)
+				if checkErr == nil && isProofUnneeded {
+					p.provingScheduler.finish(proofWithHeader.BlockID.Uint64())
+					return
+				}
+			}
+
+			p.provingScheduler.fail(proofWithHeader.BlockID.Uint64(), err)
+			return
 		}
+
+		p.provingScheduler.finish(proofWithHeader.BlockID.Uint64())
 	}()
 }
 
-// onBlockVerified update the latestVerified block in current state.
-// TODO: cancel the corresponding block's proof generation, if requested before.
+// onProofReady untracks the finished proof request of the given generated proof, and records the
+// proof in the corresponding proving job.
+func (p *Prover) onProofReady(proofWithHeader *producer.ProofWithHeader, isValidProof bool) {
+	p.untrackProofRequest(proofWithHeader.BlockID)
+
+	// Resumed jobs' proofs are not generated in this run.
+	if job, ok := p.provingJobs.get(proofWithHeader.BlockID.Uint64()); ok && job.Status == provingJobProving {
+		metrics.ProverProofGenerationTimer.UpdateSince(job.StartedAt)
+	}
+
+	if err := p.provingJobs.setProofReady(proofWithHeader, isValidProof); err != nil {
+		log.Warn("Failed to update proving job", "blockID", proofWithHeader.BlockID, "error", err)
+	}
+}
+
+// onBlockVerified update the latestVerified block in current state, and cancels the
+// in-flight proof requests of all verified blocks.
 func (p *Prover) onBlockVerified(ctx context.Context, event *bindings.TaikoL1ClientBlockVerified) error {
 	metrics.ProverLatestVerifiedIDGauge.Update(event.Id.Int64())
+	p.cursorMutex.Lock()
 	p.latestVerifiedL1Height = event.Raw.BlockNumber
+	p.cursorMutex.Unlock()
+
+	p.cancelVerifiedProofRequests(event.Id)
+	if err := p.provingJobs.prune(event.Id.Uint64()); err != nil {
+		log.Warn("Failed to prune proving jobs", "latestVerifiedID", event.Id, "error", err)
+	}
 
 	if event.BlockHash == (common.Hash{}) {
 		log.Info("New verified invalid block", "blockID", event.Id)
@@ -343,7 +565,7 @@ func (p *Prover) Name() string {
 
 // getProveBlocksTxOpts creates a bind.TransactOpts instance using the given private key.
 // Used for creating TaikoL1.proveBlock and TaikoL1.proveBlockInvalid transactions.
-func (p *Prover) getProveBlocksTxOpts(ctx context.Context, cli *ethclient.Client) (*bind.TransactOpts, error) {
+func (p *Prover) getProveBlocksTxOpts(ctx context.Context, cli *rpc.FailoverClient) (*bind.TransactOpts, error) {
 	opts, err := bind.NewKeyedTransactorWithChainID(p.cfg.L1ProverPrivKey, p.rpc.L1ChainID)
 	if err != nil {
 		return nil, err
@@ -399,21 +621,9 @@ func (p *Prover) isBlockVerified(id *big.Int) (bool, error) {
 
 // isProvenByCurrentProver checks whether the L2 block has been already proven by current prover.
 func (p *Prover) isProvenByCurrentProver(id *big.Int) (bool, error) {
-	var parentHash common.Hash
-	if id.Cmp(common.Big1) == 0 {
-		header, err := p.rpc.L2.HeaderByNumber(p.ctx, common.Big0)
-		if err != nil {
-			return false, err
-		}
-
-		parentHash = header.Hash()
-	} else {
-		parentL1Origin, err := p.rpc.WaitL1Origin(p.ctx, new(big.Int).Sub(id, common.Big1))
-		if err != nil {
-			return false, err
-		}
-
-		parentHash = parentL1Origin.L2BlockHash
+	parentHash, err := p.getParentHash(id)
+	if err != nil {
+		return false, err
 	}
 
 	provers, err := p.rpc.TaikoL1.GetBlockProvers(nil, id, parentHash)
@@ -430,15 +640,58 @@ func (p *Prover) isProvenByCurrentProver(id *big.Int) (bool, error) {
 	return false, nil
 }
 
+// getParentHash returns the hash of the given L2 block's parent block.
+func (p *Prover) getParentHash(id *big.Int) (common.Hash, error) {
+	if id.Cmp(common.Big1) == 0 {
+		header, err := p.rpc.L2.HeaderByNumber(p.ctx, common.Big0)
+		if err != nil {
+			return common.Hash{}, err
+		}
+
+		return header.Hash(), nil
+	}
+
+	parentL1Origin, err := p.rpc.WaitL1Origin(p.ctx, new(big.Int).Sub(id, common.Big1))
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	return parentL1Origin.L2BlockHash, nil
+}
+
+// recordProvingJobError records the given error as the last error of the corresponding proving job.
+func (p *Prover) recordProvingJobError(blockID *big.Int, err error) {
+	if err := p.provingJobs.setError(blockID.Uint64(), err); err != nil {
+		log.Warn("Failed to update proving job", "blockID", blockID, "error", err)
+	}
+}
+
+// setProvingJobStatus updates the status of the corresponding proving job.
+func (p *Prover) setProvingJobStatus(blockID *big.Int, status provingJobStatus) {
+	// Only the first submission of a generated proof is measured, not the retries.
+	if status == provingJobSubmitted {
+		if job, ok := p.provingJobs.get(blockID.Uint64()); ok && job.Status == provingJobProofReady {
+			metrics.ProverProofToSubmissionTimer.UpdateSince(job.ProofReadyAt)
+		}
+	}
+
+	if err := p.provingJobs.setStatus(blockID.Uint64(), status); err != nil {
+		log.Warn("Failed to update proving job", "blockID", blockID, "status", status, "error", err)
+	}
+}
+
+// errUnretryableProofSubmission is returned when a proof submission transaction fails with an
+// unretryable error, e.g. the proof is rejected by TaikoL1.
+var errUnretryableProofSubmission = errors.New("unretryable proof submission error")
+
 // isSubmitProofTxErrorRetryable checks whether the error returned by a proof submission transaction
 // is retryable.
 func isSubmitProofTxErrorRetryable(err error, blockID *big.Int) bool {
-	// Not an error returned by eth_estimateGas.
-	if !strings.Contains(err.Error(), "L1:") {
+	if rpcErrors.IsRetryable(err) {
 		return true
 	}
 
-	// Contract errors, returned by eth_estimateGas.
+	// Protocol contract reverts returned by eth_estimateGas, or other errors caused by the request itself.
 	log.Warn("🤷‍♂️ Unretryable proof submission error", "error", err, "blockID", blockID)
 	return false
 }
@@ -122,17 +122,25 @@ func (s *ProverTestSuite) TestOnBlockProposed() {
 	// Valid block
 	e := testutils.ProposeAndInsertValidBlock(&s.ClientTestSuite, s.proposer, s.d.ChainSyncer())
 	s.Nil(s.p.onBlockProposed(context.Background(), e, func() {}))
+	s.True(s.p.provingScheduler.has(e.Id.Uint64()))
+	finished, err := s.p.proveBlock(context.Background(), e)
+	s.Nil(err)
+	s.False(finished)
 	s.Nil(s.p.submitValidBlockProof(context.Background(), <-s.p.proveValidProofCh))
 
 	// Empty blocks
 	for _, e = range testutils.ProposeAndInsertEmptyBlocks(&s.ClientTestSuite, s.proposer, s.d.ChainSyncer()) {
 		s.Nil(s.p.onBlockProposed(context.Background(), e, func() {}))
+		_, err = s.p.proveBlock(context.Background(), e)
+		s.Nil(err)
 		s.Nil(s.p.submitValidBlockProof(context.Background(), <-s.p.proveValidProofCh))
 	}
 
 	// Invalid block
 	e = testutils.ProposeAndInsertThrowawayBlock(&s.ClientTestSuite, s.proposer, s.d.ChainSyncer())
 	s.Nil(s.p.onBlockProposed(context.Background(), e, func() {}))
+	_, err = s.p.proveBlock(context.Background(), e)
+	s.Nil(err)
 	s.Nil(s.p.submitInvalidBlockProof(context.Background(), <-s.p.proveInvalidProofCh))
 }
 
@@ -145,10 +153,12 @@ func (s *ProverTestSuite) TestOnBlockVerifiedEmptyBlockHash() {
 
 func (s *ProverTestSuite) TestIsSubmitProofTxErrorRetryable() {
 	s.True(isSubmitProofTxErrorRetryable(errors.New(testAddr.String()), common.Big0))
-	s.False(isSubmitProofTxErrorRetryable(errors.New("L1:proof:tooMany"), common.Big0))
-	s.False(isSubmitProofTxErrorRetryable(errors.New("L1:tooLate"), common.Big0))
-	s.False(isSubmitProofTxErrorRetryable(errors.New("L1:prover:dup"), common.Big0))
-	s.False(isSubmitProofTxErrorRetryable(errors.New("L1:"+testAddr.String()), common.Big0))
+	s.True(isSubmitProofTxErrorRetryable(errors.New("execution reverted"), common.Big0))
+	s.True(isSubmitProofTxErrorRetryable(errors.New("nonce too low"), common.Big0))
+	s.False(isSubmitProofTxErrorRetryable(errors.New("execution reverted: L1:proof:tooMany"), common.Big0))
+	s.False(isSubmitProofTxErrorRetryable(errors.New("execution reverted: L1:tooLate"), common.Big0))
+	s.False(isSubmitProofTxErrorRetryable(errors.New("execution reverted: L1:prover:dup"), common.Big0))
+	s.False(isSubmitProofTxErrorRetryable(errors.New("execution reverted: L1:"+testAddr.String()), common.Big0))
 }
 
 func TestProverTestSuite(t *testing.T) {
@@ -0,0 +1,205 @@
+package prover
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	fileUtil "github.com/taikoxyz/taiko-client/pkg/file_util"
+	"github.com/taikoxyz/taiko-client/prover/producer"
+)
+
+// provingJobStatus represents the status of a proving job.
+type provingJobStatus string
+
+// All proving job statuses.
+const (
+	provingJobQueued     provingJobStatus = "queued"
+	provingJobProving    provingJobStatus = "proving"
+	provingJobProofReady provingJobStatus = "proof-ready"
+	provingJobSubmitted  provingJobStatus = "submitted"
+	provingJobConfirmed  provingJobStatus = "confirmed"
+)
+
+// provingJob represents a job to prove a proposed L2 block, from requesting the proof to
+// having the proof submission transaction confirmed.
+type provingJob struct {
+	BlockID      uint64                    `json:"blockID"`
+	Status       provingJobStatus          `json:"status"`
+	L1Height     uint64                    `json:"l1Height"`
+	L1BlockHash  common.Hash               `json:"l1BlockHash"`
+	IsValidProof bool                      `json:"isValidProof"`
+	Proof        *producer.ProofWithHeader `json:"proof,omitempty"`
+	LastError    string                    `json:"lastError,omitempty"`
+	StartedAt    time.Time                 `json:"startedAt"`
+	ProofReadyAt time.Time                 `json:"proofReadyAt"`
+	UpdatedAt    time.Time                 `json:"updatedAt"`
+}
+
+// provingJobStore keeps track of all proving jobs, keyed by block ID. If a state file path is given,
+// all jobs will be persisted, so that the prover can resume them after restarts instead of generating
+// the same proofs again.
+type provingJobStore struct {
+	statePath string
+	jobs      map[uint64]*provingJob
+	mutex     sync.Mutex
+}
+
+// newProvingJobStore creates a new proving job store instance, and loads all jobs from the given
+// state file, if there is one.
+func newProvingJobStore(statePath string) (*provingJobStore, error) {
+	s := &provingJobStore{statePath: statePath, jobs: make(map[uint64]*provingJob)}
+
+	if len(statePath) == 0 {
+		return s, nil
+	}
+
+	data, err := os.ReadFile(statePath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return s, nil
+		}
+		return nil, fmt.Errorf("failed to read proving jobs state file: %w", err)
+	}
+
+	if err := json.Unmarshal(data, &s.jobs); err != nil {
+		return nil, fmt.Errorf("failed to decode proving jobs state file: %w", err)
+	}
+
+	if s.jobs == nil {
+		s.jobs = make(map[uint64]*provingJob)
+	}
+
+	return s, nil
+}
+
+// get returns a copy of the job with the given block ID.
+func (s *provingJobStore) get(blockID uint64) (provingJob, bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	job, ok := s.jobs[blockID]
+	if !ok {
+		return provingJob{}, false
+	}
+
+	return *job, true
+}
+
+// list returns copies of all jobs, ordered by block ID.
+func (s *provingJobStore) list() []provingJob {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	jobs := make([]provingJob, 0, len(s.jobs))
+	for _, job := range s.jobs {
+		jobs = append(jobs, *job)
+	}
+
+	sort.Slice(jobs, func(i, j int) bool { return jobs[i].BlockID < jobs[j].BlockID })
+
+	return jobs
+}
+
+// enqueue creates a new queued job for the given block, which is proposed in the given L1 block,
+// or resets the existing one.
+func (s *provingJobStore) enqueue(blockID uint64, l1Height uint64, l1BlockHash common.Hash) error {
+	return s.update(blockID, func(job *provingJob) {
+		job.Status = provingJobQueued
+		job.L1Height = l1Height
+		job.L1BlockHash = l1BlockHash
+		job.Proof = nil
+		job.LastError = ""
+	})
+}
+
+// setStatus updates the status of the job with the given block ID.
+func (s *provingJobStore) setStatus(blockID uint64, status provingJobStatus) error {
+	return s.update(blockID, func(job *provingJob) {
+		if status == provingJobProving {
+			job.StartedAt = time.Now()
+		}
+		job.Status = status
+	})
+}
+
+// setProofReady records the generated proof of the job with the given block ID.
+func (s *provingJobStore) setProofReady(proofWithHeader *producer.ProofWithHeader, isValidProof bool) error {
+	return s.update(proofWithHeader.BlockID.Uint64(), func(job *provingJob) {
+		job.Status = provingJobProofReady
+		job.ProofReadyAt = time.Now()
+		job.IsValidProof = isValidProof
+		job.Proof = proofWithHeader
+		job.LastError = ""
+	})
+}
+
+// setError records the last error of the job with the given block ID.
+func (s *provingJobStore) setError(blockID uint64, err error) error {
+	return s.update(blockID, func(job *provingJob) { job.LastError = err.Error() })
+}
+
+// prune removes all jobs whose block IDs are not greater than the given latest verified block ID,
+// since their proofs will never be needed again.
+func (s *provingJobStore) prune(latestVerifiedID uint64) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	var pruned int
+	for blockID := range s.jobs {
+		if blockID <= latestVerifiedID {
+			delete(s.jobs, blockID)
+			pruned++
+		}
+	}
+
+	if pruned == 0 {
+		return nil
+	}
+
+	log.Debug("Proving jobs pruned", "latestVerifiedID", latestVerifiedID, "pruned", pruned, "remaining", len(s.jobs))
+
+	return s.persist()
+}
+
+// update applies the given function to the job with the given block ID, a new job will be
+// created if there is no such job.
+func (s *provingJobStore) update(blockID uint64, fn func(job *provingJob)) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	job, ok := s.jobs[blockID]
+	if !ok {
+		job = &provingJob{BlockID: blockID, Status: provingJobQueued}
+		s.jobs[blockID] = job
+	}
+
+	fn(job)
+	job.UpdatedAt = time.Now()
+
+	return s.persist()
+}
+
+// persist writes all jobs to the state file, if there is one.
+func (s *provingJobStore) persist() error {
+	if len(s.statePath) == 0 {
+		return nil
+	}
+
+	data, err := json.Marshal(s.jobs)
+	if err != nil {
+		return fmt.Errorf("failed to encode proving jobs state: %w", err)
+	}
+
+	if err := fileUtil.WriteFileAtomic(s.statePath, data, 0600); err != nil {
+		return fmt.Errorf("failed to write proving jobs state file: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,89 @@
+package prover
+
+import (
+	"errors"
+	"path/filepath"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/taikoxyz/taiko-client/bindings"
+	"github.com/taikoxyz/taiko-client/prover/producer"
+	"github.com/taikoxyz/taiko-client/testutils"
+)
+
+func (s *ProverTestSuite) TestProvingJobStore() {
+	statePath := filepath.Join(s.T().TempDir(), "provingJobs.json")
+
+	store, err := newProvingJobStore(statePath)
+	s.Nil(err)
+	s.Empty(store.list())
+
+	s.Nil(store.enqueue(2, 100, testutils.RandomHash()))
+	s.Nil(store.enqueue(1, 99, testutils.RandomHash()))
+	s.Nil(store.setStatus(1, provingJobProving))
+	s.Nil(store.setError(1, errors.New("test error")))
+
+	job, ok := store.get(1)
+	s.True(ok)
+	s.False(job.StartedAt.IsZero())
+
+	proofWithHeader := &producer.ProofWithHeader{
+		BlockID: common.Big2,
+		Meta:    &bindings.LibDataBlockMetadata{Id: common.Big2, L1Height: common.Big1, GasLimit: 1024},
+		Header: &types.Header{
+			ParentHash: testutils.RandomHash(),
+			Root:       testutils.RandomHash(),
+			Difficulty: common.Big0,
+			Number:     common.Big2,
+			GasLimit:   1024,
+			Time:       uint64(time.Now().Unix()),
+		},
+		ZkProof: testutils.RandomHash().Bytes(),
+	}
+	s.Nil(store.setProofReady(proofWithHeader, true))
+
+	// All jobs should survive restarts.
+	reloaded, err := newProvingJobStore(statePath)
+	s.Nil(err)
+
+	jobs := reloaded.list()
+	s.Len(jobs, 2)
+	s.Equal(uint64(1), jobs[0].BlockID)
+	s.Equal(provingJobProving, jobs[0].Status)
+	s.Equal(uint64(99), jobs[0].L1Height)
+	s.Equal("test error", jobs[0].LastError)
+
+	job, ok = reloaded.get(2)
+	s.True(ok)
+	s.Equal(provingJobProofReady, job.Status)
+	s.False(job.ProofReadyAt.IsZero())
+	s.True(job.IsValidProof)
+	s.Equal(proofWithHeader.BlockID, job.Proof.BlockID)
+	s.Equal(proofWithHeader.Meta, job.Proof.Meta)
+	s.Equal(proofWithHeader.Header.Hash(), job.Proof.Header.Hash())
+	s.Equal(proofWithHeader.ZkProof, job.Proof.ZkProof)
+
+	// Re-enqueued jobs should be reset.
+	l1BlockHash := testutils.RandomHash()
+	s.Nil(reloaded.enqueue(1, 101, l1BlockHash))
+	job, ok = reloaded.get(1)
+	s.True(ok)
+	s.Equal(provingJobQueued, job.Status)
+	s.Equal(uint64(101), job.L1Height)
+	s.Equal(l1BlockHash, job.L1BlockHash)
+	s.Empty(job.LastError)
+
+	// Jobs of verified blocks should be pruned.
+	s.Nil(reloaded.prune(1))
+	_, ok = reloaded.get(1)
+	s.False(ok)
+	_, ok = reloaded.get(2)
+	s.True(ok)
+
+	// Stores without a state file should work in memory.
+	memStore, err := newProvingJobStore("")
+	s.Nil(err)
+	s.Nil(memStore.enqueue(1, 1, testutils.RandomHash()))
+	s.Len(memStore.list(), 1)
+}
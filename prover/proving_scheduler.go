@@ -0,0 +1,331 @@
+package prover
+
+import (
+	"container/heap"
+	"context"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/taikoxyz/taiko-client/bindings"
+)
+
+const (
+	// Backoff bounds of re-queueing a failed proving task.
+	defaultProvingTaskMinBackoff = 12 * time.Second
+	defaultProvingTaskMaxBackoff = 10 * time.Minute
+	// Interval to check whether there are backed off proving tasks ready to run again.
+	provingTaskDispatchInterval = time.Second
+)
+
+// provingTask represents a proposed block to prove.
+type provingTask struct {
+	event    *bindings.TaikoL1ClientBlockProposed
+	attempts uint64
+	readyAt  time.Time
+	running  bool
+	finished bool
+	index    int // index in the priority queue, -1 if not queued
+}
+
+// provingTaskQueue is a priority queue of proving tasks ordered by block ID,
+// implementing the heap.Interface.
+type provingTaskQueue []*provingTask
+
+func (q provingTaskQueue) Len() int { return len(q) }
+func (q provingTaskQueue) Less(i, j int) bool {
+	return q[i].event.Id.Cmp(q[j].event.Id) < 0
+}
+func (q provingTaskQueue) Swap(i, j int) {
+	q[i], q[j] = q[j], q[i]
+	q[i].index = i
+	q[j].index = j
+}
+func (q *provingTaskQueue) Push(x interface{}) {
+	task := x.(*provingTask)
+	task.index = len(*q)
+	*q = append(*q, task)
+}
+func (q *provingTaskQueue) Pop() interface{} {
+	old := *q
+	n := len(old)
+	task := old[n-1]
+	old[n-1] = nil
+	task.index = -1
+	*q = old[:n-1]
+	return task
+}
+
+// provingScheduler schedules the proving tasks in the order of block IDs, with a bounded number of
+// concurrently running tasks. Failed tasks will be re-queued with backoff, and the cursor only advances
+// from the last handled block through the consecutive blocks whose tasks are finished (i.e. proven,
+// verified or skipped).
+type provingScheduler struct {
+	maxConcurrency uint64
+	minBackoff     time.Duration
+	maxBackoff     time.Duration
+
+	// run starts the given proving task, the task keeps running until finish or fail is called.
+	run func(event *bindings.TaikoL1ClientBlockProposed)
+	// lastHandled returns the ID of the last handled block, where the cursor advances from.
+	lastHandled func() uint64
+	// onCursorAdvanced is called with the last finished block, whose preceding blocks are all finished.
+	onCursorAdvanced func(event *bindings.TaikoL1ClientBlockProposed)
+
+	tasks   map[uint64]*provingTask
+	queue   provingTaskQueue
+	running uint64
+	mutex   sync.Mutex
+	notify  chan struct{}
+}
+
+// newProvingScheduler creates a new proving scheduler instance.
+func newProvingScheduler(
+	maxConcurrency uint64,
+	run func(event *bindings.TaikoL1ClientBlockProposed),
+	lastHandled func() uint64,
+	onCursorAdvanced func(event *bindings.TaikoL1ClientBlockProposed),
+) *provingScheduler {
+	return &provingScheduler{
+		maxConcurrency:   maxConcurrency,
+		minBackoff:       defaultProvingTaskMinBackoff,
+		maxBackoff:       defaultProvingTaskMaxBackoff,
+		run:              run,
+		lastHandled:      lastHandled,
+		onCursorAdvanced: onCursorAdvanced,
+		tasks:            make(map[uint64]*provingTask),
+		notify:           make(chan struct{}, 1),
+	}
+}
+
+// push adds a new proving task for the given proposed block, returns false if there is
+// already a task for that block.
+func (s *provingScheduler) push(event *bindings.TaikoL1ClientBlockProposed) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if _, ok := s.tasks[event.Id.Uint64()]; ok {
+		return false
+	}
+
+	task := &provingTask{event: event}
+	s.tasks[event.Id.Uint64()] = task
+	heap.Push(&s.queue, task)
+
+	s.requestDispatch()
+
+	return true
+}
+
+// skip adds a finished proving task for the given proposed block, which should never be proven,
+// so that the cursor can advance past it.
+func (s *provingScheduler) skip(event *bindings.TaikoL1ClientBlockProposed) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if _, ok := s.tasks[event.Id.Uint64()]; ok {
+		return
+	}
+
+	s.tasks[event.Id.Uint64()] = &provingTask{event: event, finished: true, index: -1}
+	s.advanceCursor()
+}
+
+// has checks whether there is a proving task for the given block.
+func (s *provingScheduler) has(blockID uint64) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	_, ok := s.tasks[blockID]
+	return ok
+}
+
+// replace replaces the proposed block of the existing task for the same block ID, returns false if
+// there is no such task.
+func (s *provingScheduler) replace(event *bindings.TaikoL1ClientBlockProposed) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	task, ok := s.tasks[event.Id.Uint64()]
+	if !ok {
+		return false
+	}
+
+	task.event = event
+	return true
+}
+
+// inFlight returns the number of running tasks, and the proposed block of the oldest unfinished task,
+// which is nil if all tasks are finished.
+func (s *provingScheduler) inFlight() (uint64, *bindings.TaikoL1ClientBlockProposed) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	var oldest *bindings.TaikoL1ClientBlockProposed
+	for _, task := range s.tasks {
+		if task.finished {
+			continue
+		}
+		if oldest == nil || task.event.Id.Cmp(oldest.Id) < 0 {
+			oldest = task.event
+		}
+	}
+
+	return s.running, oldest
+}
+
+// finish marks the running task of the given block as finished.
+func (s *provingScheduler) finish(blockID uint64) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	task, ok := s.tasks[blockID]
+	if !ok || !task.running {
+		return
+	}
+
+	task.running = false
+	task.finished = true
+	s.running--
+
+	s.advanceCursor()
+	s.requestDispatch()
+}
+
+// fail marks the running task of the given block as failed, and re-queues it with backoff.
+func (s *provingScheduler) fail(blockID uint64, err error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	task, ok := s.tasks[blockID]
+	if !ok || !task.running {
+		return
+	}
+
+	task.running = false
+	task.attempts++
+	task.readyAt = time.Now().Add(s.backoff(task.attempts))
+	s.running--
+	heap.Push(&s.queue, task)
+
+	log.Warn(
+		"Proving task failed, re-queue it with backoff",
+		"blockID", blockID,
+		"attempts", task.attempts,
+		"readyAt", task.readyAt,
+		"error", err,
+	)
+
+	s.requestDispatch()
+}
+
+// backoff returns the backoff duration of a task which has failed for the given times.
+func (s *provingScheduler) backoff(attempts uint64) time.Duration {
+	backoff := s.minBackoff
+	for i := uint64(1); i < attempts && backoff < s.maxBackoff; i++ {
+		backoff *= 2
+	}
+
+	if backoff > s.maxBackoff {
+		return s.maxBackoff
+	}
+
+	return backoff
+}
+
+// next pops the ready task with the lowest block ID from the queue, if the number of
+// running tasks doesn't reach the limit.
+func (s *provingScheduler) next(now time.Time) *provingTask {
+	if s.running >= s.maxConcurrency {
+		return nil
+	}
+
+	var (
+		notReady []*provingTask
+		selected *provingTask
+	)
+	for s.queue.Len() > 0 {
+		task := heap.Pop(&s.queue).(*provingTask)
+		if task.readyAt.After(now) {
+			notReady = append(notReady, task)
+			continue
+		}
+
+		selected = task
+		break
+	}
+
+	for _, task := range notReady {
+		heap.Push(&s.queue, task)
+	}
+
+	if selected != nil {
+		selected.running = true
+		s.running++
+	}
+
+	return selected
+}
+
+// dispatch starts as many ready tasks as possible.
+func (s *provingScheduler) dispatch() {
+	for {
+		s.mutex.Lock()
+		task := s.next(time.Now())
+		s.mutex.Unlock()
+
+		if task == nil {
+			return
+		}
+
+		go s.run(task.event)
+	}
+}
+
+// advanceCursor removes the finished tasks of the consecutive blocks following the last handled
+// block, and advances the cursor past them. Finished tasks of the already handled blocks, e.g. the
+// ones enqueued manually, are removed without moving the cursor, and finished tasks after a gap are
+// kept until the blocks in the gap are finished.
+func (s *provingScheduler) advanceCursor() {
+	lastHandled := s.lastHandled()
+	for id, task := range s.tasks {
+		if id <= lastHandled && task.finished {
+			delete(s.tasks, id)
+		}
+	}
+
+	var last *provingTask
+	for task := s.tasks[lastHandled+1]; task != nil && task.finished; task = s.tasks[task.event.Id.Uint64()+1] {
+		delete(s.tasks, task.event.Id.Uint64())
+		last = task
+	}
+
+	if last != nil && s.onCursorAdvanced != nil {
+		s.onCursorAdvanced(last.event)
+	}
+}
+
+// requestDispatch requests a dispatching operation, won't block if there is already one requested.
+func (s *provingScheduler) requestDispatch() {
+	select {
+	case s.notify <- struct{}{}:
+	default:
+	}
+}
+
+// loop keeps dispatching the ready tasks until the given context is done.
+func (s *provingScheduler) loop(ctx context.Context) {
+	ticker := time.NewTicker(provingTaskDispatchInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.notify:
+			s.dispatch()
+		case <-ticker.C:
+			s.dispatch()
+		}
+	}
+}
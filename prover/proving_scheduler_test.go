@@ -0,0 +1,145 @@
+package prover
+
+import (
+	"errors"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/taikoxyz/taiko-client/bindings"
+)
+
+func newTestBlockProposedEvent(id uint64) *bindings.TaikoL1ClientBlockProposed {
+	return &bindings.TaikoL1ClientBlockProposed{
+		Id:  new(big.Int).SetUint64(id),
+		Raw: types.Log{BlockNumber: id * 10},
+	}
+}
+
+func (s *ProverTestSuite) TestProvingScheduler() {
+	var (
+		started []uint64
+		cursor  *bindings.TaikoL1ClientBlockProposed
+	)
+	scheduler := newProvingScheduler(
+		2,
+		func(event *bindings.TaikoL1ClientBlockProposed) {},
+		func() uint64 {
+			if cursor == nil {
+				return 0
+			}
+			return cursor.Id.Uint64()
+		},
+		func(event *bindings.TaikoL1ClientBlockProposed) { cursor = event },
+	)
+	scheduler.minBackoff = time.Hour
+	scheduler.maxBackoff = 2 * time.Hour
+
+	next := func() {
+		scheduler.mutex.Lock()
+		defer scheduler.mutex.Unlock()
+
+		if task := scheduler.next(time.Now()); task != nil {
+			started = append(started, task.event.Id.Uint64())
+		}
+	}
+
+	for _, id := range []uint64{3, 1, 2, 4} {
+		s.True(scheduler.push(newTestBlockProposedEvent(id)))
+	}
+	s.False(scheduler.push(newTestBlockProposedEvent(1)))
+
+	// Tasks should be started in the order of block IDs, and bounded by the max concurrency.
+	next()
+	next()
+	next()
+	s.Equal([]uint64{1, 2}, started)
+
+	running, oldest := scheduler.inFlight()
+	s.Equal(uint64(2), running)
+	s.Equal(uint64(1), oldest.Id.Uint64())
+
+	// The cursor should not advance past unfinished blocks.
+	scheduler.finish(2)
+	s.Nil(cursor)
+	next()
+	s.Equal([]uint64{1, 2, 3}, started)
+
+	// Failed tasks should be re-queued with backoff.
+	scheduler.fail(1, errors.New("test error"))
+	next()
+	s.Equal([]uint64{1, 2, 3, 4}, started)
+	s.True(scheduler.has(1))
+	s.Equal(uint64(1), scheduler.tasks[1].attempts)
+
+	scheduler.mutex.Lock()
+	task := scheduler.next(time.Now().Add(2 * time.Hour))
+	scheduler.mutex.Unlock()
+	s.Nil(task)
+
+	scheduler.finish(4)
+	scheduler.mutex.Lock()
+	task = scheduler.next(time.Now().Add(2 * time.Hour))
+	scheduler.mutex.Unlock()
+	s.Equal(uint64(1), task.event.Id.Uint64())
+
+	// The cursor should advance past all finished blocks in order.
+	scheduler.finish(1)
+	s.Equal(uint64(2), cursor.Id.Uint64())
+	s.Equal(uint64(20), cursor.Raw.BlockNumber)
+
+	scheduler.finish(3)
+	s.Equal(uint64(4), cursor.Id.Uint64())
+	s.Empty(scheduler.tasks)
+
+	// Skipped blocks should advance the cursor directly.
+	scheduler.skip(newTestBlockProposedEvent(5))
+	s.Equal(uint64(5), cursor.Id.Uint64())
+
+	// Only existing tasks' proposed blocks can be replaced.
+	s.False(scheduler.replace(newTestBlockProposedEvent(6)))
+	s.True(scheduler.push(newTestBlockProposedEvent(6)))
+	reorged := newTestBlockProposedEvent(6)
+	reorged.Raw.BlockNumber++
+	s.True(scheduler.replace(reorged))
+	scheduler.mutex.Lock()
+	task = scheduler.next(time.Now())
+	scheduler.mutex.Unlock()
+	s.Equal(reorged.Raw.BlockNumber, task.event.Raw.BlockNumber)
+	scheduler.finish(6)
+	s.Equal(reorged.Raw.BlockNumber, cursor.Raw.BlockNumber)
+
+	running, oldest = scheduler.inFlight()
+	s.Zero(running)
+	s.Nil(oldest)
+
+	// Finished tasks after a gap should not advance the cursor, e.g. the ones enqueued manually.
+	s.True(scheduler.push(newTestBlockProposedEvent(9)))
+	scheduler.mutex.Lock()
+	task = scheduler.next(time.Now())
+	scheduler.mutex.Unlock()
+	s.Equal(uint64(9), task.event.Id.Uint64())
+	scheduler.finish(9)
+	s.Equal(uint64(6), cursor.Id.Uint64())
+	s.True(scheduler.has(9))
+
+	// Finished tasks of the handled blocks should be removed without moving the cursor back.
+	s.True(scheduler.push(newTestBlockProposedEvent(3)))
+	scheduler.mutex.Lock()
+	task = scheduler.next(time.Now())
+	scheduler.mutex.Unlock()
+	scheduler.finish(task.event.Id.Uint64())
+	s.Equal(uint64(6), cursor.Id.Uint64())
+	s.False(scheduler.has(3))
+
+	for _, id := range []uint64{7, 8} {
+		scheduler.skip(newTestBlockProposedEvent(id))
+	}
+	s.Equal(uint64(9), cursor.Id.Uint64())
+	s.Empty(scheduler.tasks)
+
+	// Backoff should be increased exponentially, and bounded.
+	s.Equal(time.Hour, scheduler.backoff(1))
+	s.Equal(2*time.Hour, scheduler.backoff(2))
+	s.Equal(2*time.Hour, scheduler.backoff(10))
+}
@@ -0,0 +1,103 @@
+package prover
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/taikoxyz/taiko-client/bindings"
+	"github.com/taikoxyz/taiko-client/bindings/encoding"
+	"github.com/taikoxyz/taiko-client/metrics"
+)
+
+// rederiveBlock rebuilds the expected L2 block from the L1 TaikoL1.proposeBlock transaction calldata, and
+// checks whether the given L2 block from the L2 execution engine matches it.
+func (p *Prover) rederiveBlock(
+	ctx context.Context,
+	event *bindings.TaikoL1ClientBlockProposed,
+	block *types.Block,
+) error {
+	proposeBlockTx, err := p.rpc.L1.TransactionInBlock(ctx, event.Raw.BlockHash, event.Raw.TxIndex)
+	if err != nil {
+		return fmt.Errorf("failed to fetch TaikoL1.proposeBlock transaction: %w", err)
+	}
+
+	txListBytes, err := encoding.UnpackTxListBytes(proposeBlockTx.Data())
+	if err != nil {
+		return fmt.Errorf("failed to unpack transactions list bytes: %w", err)
+	}
+
+	var txs types.Transactions
+	if len(txListBytes) != 0 {
+		if err := rlp.DecodeBytes(txListBytes, &txs); err != nil {
+			return fmt.Errorf("failed to decode transactions list bytes: %w", err)
+		}
+	}
+
+	if err := checkRederivedBlock(&event.Meta, p.protocolConstants.AnchorTxGasLimit.Uint64(), txs, block); err != nil {
+		metrics.ProverRederivationMismatchCounter.Inc(1)
+		log.Error(
+			"Re-derived block mismatch, refuse to prove",
+			"blockID", event.Id,
+			"hash", block.Hash(),
+			"l1Height", event.Raw.BlockNumber,
+			"error", err,
+		)
+		return err
+	}
+
+	return nil
+}
+
+// checkRederivedBlock checks whether the given L2 block matches the block which is re-derived from
+// the given block metadata and decoded proposed transactions. The anchor transaction, which is the
+// first transaction in the L2 block, is validated separately by validateAnchorTx.
+func checkRederivedBlock(
+	meta *bindings.LibDataBlockMetadata,
+	anchorTxGasLimit uint64,
+	proposedTxs types.Transactions,
+	block *types.Block,
+) error {
+	if block.Time() != meta.Timestamp {
+		return fmt.Errorf("timestamp mismatch, expected: %d, actual: %d", meta.Timestamp, block.Time())
+	}
+
+	if block.Coinbase() != meta.Beneficiary {
+		return fmt.Errorf("beneficiary mismatch, expected: %s, actual: %s", meta.Beneficiary, block.Coinbase())
+	}
+
+	if block.MixDigest() != common.Hash(meta.MixHash) {
+		return fmt.Errorf("mix hash mismatch, expected: %s, actual: %s", common.Hash(meta.MixHash), block.MixDigest())
+	}
+
+	if block.GasLimit() != meta.GasLimit+anchorTxGasLimit {
+		return fmt.Errorf(
+			"gas limit mismatch, expected: %d, actual: %d", meta.GasLimit+anchorTxGasLimit, block.GasLimit(),
+		)
+	}
+
+	// The L2 execution engine skips the proposed transactions which can't be applied, e.g. the ones
+	// with invalid nonces or insufficient balances, so the transactions after the anchor transaction
+	// must be an ordered subsequence of the proposed transactions.
+	txs := block.Transactions()
+	if txs.Len() == 0 {
+		return errors.New("missing anchor transaction")
+	}
+
+	next := 0
+	for i, tx := range txs[1:] {
+		for next < proposedTxs.Len() && proposedTxs[next].Hash() != tx.Hash() {
+			next++
+		}
+		if next == proposedTxs.Len() {
+			return fmt.Errorf("transaction %d not proposed or out of order: %s", i+1, tx.Hash())
+		}
+		next++
+	}
+
+	return nil
+}
@@ -0,0 +1,86 @@
+package prover
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/taikoxyz/taiko-client/bindings"
+	"github.com/taikoxyz/taiko-client/testutils"
+)
+
+func (s *ProverTestSuite) TestCheckRederivedBlock() {
+	var (
+		anchorTxGasLimit uint64 = 250000
+		meta                    = &bindings.LibDataBlockMetadata{
+			Id:          common.Big1,
+			Beneficiary: common.BytesToAddress(testutils.RandomBytes(20)),
+			MixHash:     testutils.RandomHash(),
+			GasLimit:    1000000,
+			Timestamp:   1234567,
+		}
+		newTx = func(nonce uint64) *types.Transaction {
+			return types.NewTransaction(nonce, common.Address{}, common.Big0, 21000, common.Big1, nil)
+		}
+		anchorTx    = newTx(0)
+		proposedTxs = types.Transactions{newTx(1), newTx(2)}
+		header      = &types.Header{
+			Number:    big.NewInt(1),
+			Time:      meta.Timestamp,
+			Coinbase:  meta.Beneficiary,
+			MixDigest: meta.MixHash,
+			GasLimit:  meta.GasLimit + anchorTxGasLimit,
+		}
+		newBlock = func(header *types.Header, txs types.Transactions) *types.Block {
+			return types.NewBlock(header, txs, nil, nil, trie.NewStackTrie(nil))
+		}
+	)
+
+	s.Nil(checkRederivedBlock(
+		meta, anchorTxGasLimit, proposedTxs, newBlock(header, append(types.Transactions{anchorTx}, proposedTxs...)),
+	))
+
+	// An empty proposed transactions list.
+	s.Nil(checkRederivedBlock(meta, anchorTxGasLimit, nil, newBlock(header, types.Transactions{anchorTx})))
+
+	// Some proposed transactions are skipped by the L2 execution engine.
+	s.Nil(checkRederivedBlock(meta, anchorTxGasLimit, proposedTxs, newBlock(header, types.Transactions{anchorTx})))
+	s.Nil(checkRederivedBlock(
+		meta, anchorTxGasLimit, proposedTxs, newBlock(header, types.Transactions{anchorTx, newTx(2)}),
+	))
+
+	// Transactions mismatch.
+	s.ErrorContains(
+		checkRederivedBlock(meta, anchorTxGasLimit, proposedTxs, newBlock(header, types.Transactions{})),
+		"missing anchor transaction",
+	)
+	s.ErrorContains(
+		checkRederivedBlock(
+			meta, anchorTxGasLimit, proposedTxs, newBlock(header, types.Transactions{anchorTx, newTx(2), newTx(1)}),
+		),
+		"transaction 2 not proposed or out of order",
+	)
+	s.ErrorContains(
+		checkRederivedBlock(
+			meta, anchorTxGasLimit, proposedTxs, newBlock(header, types.Transactions{anchorTx, newTx(1), newTx(3)}),
+		),
+		"transaction 2 not proposed or out of order",
+	)
+
+	// Block context mismatch.
+	for _, tt := range []struct {
+		modify func(header *types.Header)
+		errMsg string
+	}{
+		{func(header *types.Header) { header.Time++ }, "timestamp mismatch"},
+		{func(header *types.Header) { header.Coinbase = common.Address{} }, "beneficiary mismatch"},
+		{func(header *types.Header) { header.MixDigest = common.Hash{} }, "mix hash mismatch"},
+		{func(header *types.Header) { header.GasLimit = meta.GasLimit }, "gas limit mismatch"},
+	} {
+		h := types.CopyHeader(header)
+		tt.modify(h)
+		block := newBlock(h, append(types.Transactions{anchorTx}, proposedTxs...))
+		s.ErrorContains(checkRederivedBlock(meta, anchorTxGasLimit, proposedTxs, block), tt.errMsg)
+	}
+}
@@ -3,6 +3,7 @@ package prover
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/cenkalti/backoff/v4"
 	"github.com/ethereum/go-ethereum/core/types"
@@ -25,30 +26,51 @@ func (p *Prover) proveBlockValid(ctx context.Context, event *bindings.TaikoL1Cli
 
 	// This should not be reached, only check for safety.
 	if l1Origin.Throwaway {
-		log.Error("Get a block metadata with invalid transaction list", "l1Origin", l1Origin)
-		return nil
+		return fmt.Errorf("unexpected throwaway block for a valid transaction list, blockID %s", event.Id)
 	}
 
-	// Get the header of the block to prove from L2 execution engine.
-	header, err := p.rpc.L2.HeaderByHash(ctx, l1Origin.L2BlockHash)
+	// Get the block to prove from L2 execution engine.
+	block, err := p.rpc.L2.BlockByHash(ctx, l1Origin.L2BlockHash)
 	if err != nil {
 		return err
 	}
+	header := block.Header()
+
+	// Validate TaikoL2.anchor transaction before proving, a block built by a faulty driver
+	// should never be proven valid.
+	if block.Transactions().Len() == 0 {
+		return fmt.Errorf("invalid block without anchor transaction, blockID %s", event.Id)
+	}
+	if err := p.validateAnchorTx(ctx, block.Transactions()[0], &event.Meta); err != nil {
+		return fmt.Errorf("invalid anchor transaction: %w", err)
+	}
+
+	// Re-derive the block from L1 independently, if enabled, to make sure the block
+	// inserted by the driver is exactly the proposed one.
+	if p.cfg.RederiveBlocks {
+		if err := p.rederiveBlock(ctx, event, block); err != nil {
+			return fmt.Errorf("failed to re-derive block, blockID %s: %w", event.Id, err)
+		}
+	}
 
 	// Request proof.
 	opts := &producer.ProofRequestOptions{
-		Height:     header.Number,
-		L2Endpoint: p.cfg.L2Endpoint,
-		Retry:      false,
-		Param:      p.cfg.ZkEvmRpcdParamsPath,
+		Height:      header.Number,
+		L2Endpoint:  p.cfg.L2Endpoint,
+		Retry:       false,
+		Param:       p.cfg.ZkEvmRpcdParamsPath,
+		OnRequested: p.onProofRequested,
 	}
 
-	if err := p.proofProducer.RequestProof(opts, event.Id, &event.Meta, header, p.proveValidProofCh); err != nil {
+	if _, err := p.proofProducer.RequestProof(
+		ctx, opts, event.Id, &event.Meta, header, p.proveValidProofCh,
+	); err != nil {
 		return err
 	}
 
 	metrics.ProverQueuedProofCounter.Inc(1)
 	metrics.ProverQueuedValidProofCounter.Inc(1)
+	metrics.ProverProposalToRequestTimer.UpdateSince(time.Unix(int64(event.Meta.Timestamp), 0))
 
 	return nil
 }
@@ -91,7 +113,7 @@ func (p *Prover) submitValidBlockProof(ctx context.Context, proofWithHeader *pro
 
 	// Validate TaikoL2.anchor transaction inside the L2 block.
 	anchorTx := block.Transactions()[0]
-	if err := p.validateAnchorTx(ctx, anchorTx); err != nil {
+	if err := p.validateAnchorTx(ctx, anchorTx, proofWithHeader.Meta); err != nil {
 		return fmt.Errorf("invalid anchor transaction: %w", err)
 	}
 
@@ -125,6 +147,14 @@ func (p *Prover) submitValidBlockProof(ctx context.Context, proofWithHeader *pro
 		)
 	}
 
+	// Verify the merkle proofs locally, so that malformed evidence won't be submitted to L1.
+	if err := verifyTrieProofOfItem(header.TxHash, block.Transactions(), 0, anchorTxProof); err != nil {
+		return fmt.Errorf("invalid anchor transaction proof: %w", err)
+	}
+	if err := verifyTrieProofOfItem(header.ReceiptHash, receipts, 0, anchorReceiptProof); err != nil {
+		return fmt.Errorf("invalid anchor receipt proof: %w", err)
+	}
+
 	// Assemble the TaikoL1.proveBlock transaction inputs.
 	proofs := [][]byte{}
 	for i := 0; i < int(p.protocolConstants.ZKProofsPerBlock.Uint64()); i++ {
@@ -144,13 +174,21 @@ func (p *Prover) submitValidBlockProof(ctx context.Context, proofWithHeader *pro
 		return fmt.Errorf("failed to encode TaikoL1.proveBlock inputs: %w", err)
 	}
 
+	// Save the proof with its evidence inputs, so that it can be re-sent later if the submission fails.
+	p.saveProofArtifact(newProofArtifact(blockID, header.Hash(), true, input, anchorTxProof, anchorReceiptProof))
+
+	// Make sure the block metadata in evidence is not outdated by an L1 reorg.
+	if err := p.checkProposedEventCanonical(ctx, blockID); err != nil {
+		return err
+	}
+
 	// Send the TaikoL1.proveBlock transaction.
 	txOpts, err := p.getProveBlocksTxOpts(ctx, p.rpc.L1)
 	if err != nil {
 		return err
 	}
 
-	var isUnretryableError bool
+	var unretryableErr error
 	if err := backoff.Retry(func() error {
 		if p.ctx.Err() != nil {
 			return nil
@@ -169,14 +207,18 @@ func (p *Prover) submitValidBlockProof(ctx context.Context, proofWithHeader *pro
 				return err
 			}
 
-			isUnretryableError = true
+			unretryableErr = err
 			return nil
 		}
 
+		p.setProvingJobStatus(blockID, provingJobSubmitted)
+
+		sentAt := time.Now()
 		if _, err := rpc.WaitReceipt(ctx, p.rpc.L1, tx); err != nil {
 			log.Warn("Failed to wait till transaction executed", "blockID", blockID, "txHash", tx.Hash(), "error", err)
 			return err
 		}
+		metrics.ProverSubmissionToReceiptTimer.UpdateSince(sentAt)
 
 		return nil
 	}, backoff.NewExponentialBackOff()); err != nil {
@@ -187,10 +229,12 @@ func (p *Prover) submitValidBlockProof(ctx context.Context, proofWithHeader *pro
 		return p.ctx.Err()
 	}
 
-	if isUnretryableError {
-		return nil
+	if unretryableErr != nil {
+		return fmt.Errorf("%w, TaikoL1.proveBlock: %v", errUnretryableProofSubmission, unretryableErr)
 	}
 
+	p.setProvingJobStatus(blockID, provingJobConfirmed)
+
 	log.Info(
 		"✅ Valid block proved",
 		"blockID", proofWithHeader.BlockID,
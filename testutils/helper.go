@@ -22,7 +22,6 @@ func ProposeInvalidTxListBytes(s *ClientTestSuite, proposer Proposer) {
 		context.Background(),
 		invalidTxListBytes,
 		uint64(rand.Int63n(constants.BlockMaxGasLimit.Int64())),
-		0,
 	)
 	s.Nil(err)
 
@@ -49,7 +48,7 @@ func ProposeAndInsertEmptyBlocks(
 	}()
 
 	// Zero byte txList
-	meta, commitTx, err := proposer.CommitTxList(context.Background(), []byte{}, 1024, 0)
+	meta, commitTx, err := proposer.CommitTxList(context.Background(), []byte{}, 1024)
 	s.Nil(err)
 
 	s.Nil(proposer.ProposeTxList(context.Background(), meta, commitTx, []byte{}, 0))
@@ -59,7 +58,7 @@ func ProposeAndInsertEmptyBlocks(
 	encoded, err := rlp.EncodeToBytes(emptyTxs)
 	s.Nil(err)
 
-	meta, commitTx, err = proposer.CommitTxList(context.Background(), encoded, 1024, 0)
+	meta, commitTx, err = proposer.CommitTxList(context.Background(), encoded, 1024)
 	s.Nil(err)
 
 	s.Nil(proposer.ProposeTxList(context.Background(), meta, commitTx, encoded, 0))
@@ -15,7 +15,7 @@ type L2ChainSyncer interface {
 type Proposer interface {
 	utils.SubcommandApplication
 	ProposeOp(ctx context.Context) error
-	CommitTxList(ctx context.Context, txListBytes []byte, gasLimit uint64, splittedIdx int) (
+	CommitTxList(ctx context.Context, txListBytes []byte, gasLimit uint64) (
 		*bindings.LibDataBlockMetadata,
 		*types.Transaction,
 		error,